@@ -5,14 +5,13 @@ go 1.20
 require (
 	github.com/golang/protobuf v1.5.2
 	github.com/umenosuke/labelinglog v1.1.1
-	github.com/umenosuke/pinger4 v1.1.4
+	golang.org/x/net v0.7.0
+	google.golang.org/genproto v0.0.0-20230303212802-e74f57abe488
 	google.golang.org/grpc v1.53.0
 )
 
 require (
-	golang.org/x/net v0.7.0 // indirect
 	golang.org/x/sys v0.6.0 // indirect
 	golang.org/x/text v0.8.0 // indirect
-	google.golang.org/genproto v0.0.0-20230303212802-e74f57abe488 // indirect
 	google.golang.org/protobuf v1.28.1 // indirect
 )
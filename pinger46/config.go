@@ -0,0 +1,65 @@
+package pinger46
+
+const terminateTimeOutSec = 10
+const responseListNum = 0x1000
+const responseMTU = 1500
+const chBufferSize = 2000
+
+const tcpTargetPrefix = "tcp://"
+const udpTargetPrefix = "udp://"
+
+// Config a
+type Config struct {
+	DebugEnable            bool    `json:"DebugEnable"`
+	DebugPrintIntervalSec  int64   `json:"DebugPrintInterval"`
+	SourceIPAddress        string  `json:"SourceIPAddress"`
+	SourceIPv6Address      string  `json:"SourceIPv6Address"`
+	StartSendIcmpSmoothing bool    `json:"StartSendIcmpSmoothing"`
+	IntervalMillisec       int64   `json:"IntervalMillisec"`
+	TimeoutMillisec        int64   `json:"TimeoutMillisec"`
+	StatisticsCountsNum    int64   `json:"StatisticsCountsNum"`
+	Mode                   Mode    `json:"Mode"`
+	MaxHops                int64   `json:"MaxHops"`
+	TTL                    int64   `json:"TTL"`
+	TOS                    int64   `json:"TOS"`
+	PayloadSize            int64   `json:"PayloadSize"`
+	PayloadPattern         []byte  `json:"PayloadPattern"`
+	DontFragment           bool    `json:"DontFragment"`
+	StatisticsWindowsSec   []int64 `json:"StatisticsWindowsSec"`
+	DownLossCount          int64   `json:"DownLossCount"`
+	UpSuccessCount         int64   `json:"UpSuccessCount"`
+	DegradedLossPercent    float64 `json:"DegradedLossPercent"`
+	HoldDownMillisec       int64   `json:"HoldDownMillisec"`
+	//0 is unlimited
+	Count int64 `json:"Count"`
+	//0 is unlimited
+	MaxTargets int64 `json:"MaxTargets"`
+}
+
+// DefaultConfig a
+func DefaultConfig() Config {
+	return Config{
+		DebugEnable:            false,
+		DebugPrintIntervalSec:  1,
+		SourceIPAddress:        "0.0.0.0",
+		SourceIPv6Address:      "::",
+		StartSendIcmpSmoothing: true,
+		IntervalMillisec:       500,
+		TimeoutMillisec:        1000,
+		StatisticsCountsNum:    50,
+		Mode:                   ModePing,
+		MaxHops:                30,
+		TTL:                    0,
+		TOS:                    0,
+		PayloadSize:            0,
+		PayloadPattern:         nil,
+		DontFragment:           false,
+		StatisticsWindowsSec:   nil,
+		DownLossCount:          3,
+		UpSuccessCount:         3,
+		DegradedLossPercent:    0,
+		HoldDownMillisec:       0,
+		Count:                  0,
+		MaxTargets:             0,
+	}
+}
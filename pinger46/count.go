@@ -0,0 +1,107 @@
+package pinger46
+
+//GetChCompleted is closed when every target has sent Count probes and got all the results
+func (thisPinger *Pinger) GetChCompleted() <-chan struct{} {
+	return thisPinger.completed.ch
+}
+
+//IsCompleted a
+func (thisPinger *Pinger) IsCompleted() bool {
+	select {
+	case <-thisPinger.completed.ch:
+		return true
+	default:
+		return false
+	}
+}
+
+//isFinalResult reports whether the result settles the probe, one per sent probe
+func isFinalResult(resultType IcmpResultType) bool {
+	switch resultType {
+	case IcmpResultTypeReceiveAfterTimeout:
+		return false
+	case IcmpResultTypeRedirect:
+		return false
+	default:
+		return true
+	}
+}
+
+//ProbeTotal counts probes settled since the target was added
+type ProbeTotal struct {
+	SentCount     int64
+	ReceivedCount int64
+}
+
+//GetProbeTotals a
+func (thisPinger *Pinger) GetProbeTotals() map[TargetID]ProbeTotal {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	res := make(map[TargetID]ProbeTotal, len(thisPinger.targets))
+	for id, target := range thisPinger.targets {
+		target.probeCount.Lock()
+		res[id] = ProbeTotal{
+			SentCount:     target.probeCount.settled,
+			ReceivedCount: target.probeCount.received,
+		}
+		target.probeCount.Unlock()
+	}
+
+	return res
+}
+
+func (thisPinger *Pinger) finishSending(target probeTarget) {
+	target.probeCount.Lock()
+	target.probeCount.isSendDone = true
+	target.probeCount.Unlock()
+
+	thisPinger.checkCompleted()
+}
+
+//finishProbe hop probes count only for completion, not for the target's totals
+func (thisPinger *Pinger) finishProbe(targetID TargetID, resultType IcmpResultType, isHop bool) {
+	target, ok := thisPinger.getTarget(targetID)
+	if !ok {
+		return
+	}
+
+	target.probeCount.Lock()
+	target.probeCount.finished++
+	if !isHop {
+		target.probeCount.settled++
+		if resultType == IcmpResultTypeReceive {
+			target.probeCount.received++
+		}
+	}
+	target.probeCount.Unlock()
+
+	thisPinger.checkCompleted()
+}
+
+func (thisPinger *Pinger) checkCompleted() {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	thisPinger.checkCompletedWithoutLock()
+}
+
+//checkCompletedWithoutLock must be called with targetsLock held
+func (thisPinger *Pinger) checkCompletedWithoutLock() {
+	if thisPinger.config.Count <= 0 {
+		return
+	}
+
+	for _, target := range thisPinger.targets {
+		target.probeCount.Lock()
+		isDone := target.probeCount.isSendDone && target.probeCount.finished >= target.probeCount.sent
+		target.probeCount.Unlock()
+		if !isDone {
+			return
+		}
+	}
+
+	thisPinger.completed.once.Do(func() {
+		close(thisPinger.completed.ch)
+	})
+}
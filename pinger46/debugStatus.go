@@ -1,4 +1,4 @@
-package pinger4
+package pinger46
 
 import (
 	"context"
@@ -25,6 +25,9 @@ func (thisPinger *Pinger) debugStatus(ctx context.Context, wg *sync.WaitGroup) {
 
 			resultDropCount := atomic.LoadInt64(&thisPinger.status.resultDropCounter)
 			thisPinger.logger.Log(labelinglog.FlgDebug, "total drop result "+strconv.FormatInt(resultDropCount, 10))
+
+			responseDropCount := atomic.LoadInt64(&thisPinger.status.responseDropCounter)
+			thisPinger.logger.Log(labelinglog.FlgDebug, "total drop response "+strconv.FormatInt(responseDropCount, 10))
 		}
 	}
 }
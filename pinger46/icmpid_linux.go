@@ -0,0 +1,41 @@
+//go:build linux
+
+package pinger46
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+//UsedIcmpIDs returns the ICMP identifiers bound by the ping sockets on this host
+//best effort, raw socket users such as other ping tools pick identifiers in the packet and are not visible
+func UsedIcmpIDs() map[int]struct{} {
+	res := make(map[int]struct{})
+	for _, path := range []string{"/proc/net/icmp", "/proc/net/icmp6"} {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		lines := strings.Split(string(data), "\n")
+		for _, line := range lines[1:] {
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				continue
+			}
+			//local_address is "address:identifier" in hex
+			index := strings.LastIndex(fields[1], ":")
+			if index < 0 {
+				continue
+			}
+			id, err := strconv.ParseUint(fields[1][index+1:], 16, 16)
+			if err != nil {
+				continue
+			}
+			res[int(id)] = struct{}{}
+		}
+	}
+
+	return res
+}
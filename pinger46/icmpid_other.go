@@ -0,0 +1,9 @@
+//go:build !linux
+
+package pinger46
+
+//UsedIcmpIDs returns the ICMP identifiers bound by the ping sockets on this host
+//best effort, always empty on this OS
+func UsedIcmpIDs() map[int]struct{} {
+	return make(map[int]struct{})
+}
@@ -0,0 +1,106 @@
+package pinger46
+
+import (
+	"sync/atomic"
+)
+
+//Info a
+type Info struct {
+	IcmpID  int
+	Targets map[TargetID]struct {
+		IPAddress string
+		Comment   string
+	}
+	TargetsOrder         []TargetID
+	StatisticsCountsNum  int64
+	IntervalMillisec     int64
+	TimeoutMillisec      int64
+	Mode                 Mode
+	MaxHops              int64
+	TTL                  int64
+	TOS                  int64
+	PayloadSize          int64
+	PayloadPattern       []byte
+	DontFragment         bool
+	StatisticsWindowsSec []int64
+	DownLossCount        int64
+	UpSuccessCount       int64
+	DegradedLossPercent  float64
+	HoldDownMillisec     int64
+	Count                int64
+	TimeouterCounter     int64
+	ResultDropCounter    int64
+}
+
+// GetIcmpID is
+func (thisPinger *Pinger) GetIcmpID() int {
+	return thisPinger.icmpID
+}
+
+// GetInfo is
+func (thisPinger *Pinger) GetInfo() Info {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	targets := make(map[TargetID]struct {
+		IPAddress string
+		Comment   string
+	})
+	for key, target := range thisPinger.targets {
+		targets[key] = struct {
+			IPAddress string
+			Comment   string
+		}{
+			IPAddress: target.ipAddress,
+			Comment:   target.comment,
+		}
+	}
+
+	targetsOrder := append(make([]TargetID, 0, len(thisPinger.targetsOrder)), (thisPinger.targetsOrder)...)
+
+	return Info{
+		IcmpID:               thisPinger.icmpID,
+		Targets:              targets,
+		TargetsOrder:         targetsOrder,
+		StatisticsCountsNum:  thisPinger.config.StatisticsCountsNum,
+		IntervalMillisec:     thisPinger.getIntervalMillisec(),
+		TimeoutMillisec:      thisPinger.getTimeoutMillisec(),
+		Mode:                 thisPinger.config.Mode,
+		MaxHops:              thisPinger.config.MaxHops,
+		TTL:                  thisPinger.config.TTL,
+		TOS:                  thisPinger.config.TOS,
+		PayloadSize:          thisPinger.config.PayloadSize,
+		PayloadPattern:       append([]byte(nil), thisPinger.config.PayloadPattern...),
+		DontFragment:         thisPinger.config.DontFragment,
+		StatisticsWindowsSec: append([]int64(nil), thisPinger.config.StatisticsWindowsSec...),
+		DownLossCount:        thisPinger.config.DownLossCount,
+		UpSuccessCount:       thisPinger.config.UpSuccessCount,
+		DegradedLossPercent:  thisPinger.config.DegradedLossPercent,
+		HoldDownMillisec:     thisPinger.config.HoldDownMillisec,
+		Count:                thisPinger.config.Count,
+		TimeouterCounter:     atomic.LoadInt64(&thisPinger.status.timeouterCounter),
+		ResultDropCounter:    atomic.LoadInt64(&thisPinger.status.resultDropCounter),
+	}
+}
+
+//DropCounts counts results and changes lost inside the pinger, before they reach any subscriber
+type DropCounts struct {
+	//results dropped before statistics
+	Result                int64
+	ResultSubscriber      int64
+	PathChangeSubscriber  int64
+	TargetStateSubscriber int64
+	//replies dropped before parsing, reported as timeouts
+	Response int64
+}
+
+//GetDropCounts a
+func (thisPinger *Pinger) GetDropCounts() DropCounts {
+	return DropCounts{
+		Result:                atomic.LoadInt64(&thisPinger.status.resultDropCounter),
+		ResultSubscriber:      atomic.LoadInt64(&thisPinger.status.resultSubscriberDropCounter),
+		PathChangeSubscriber:  atomic.LoadInt64(&thisPinger.status.pathChangeSubscriberDropCounter),
+		TargetStateSubscriber: atomic.LoadInt64(&thisPinger.status.targetStateSubscriberDropCounter),
+		Response:              atomic.LoadInt64(&thisPinger.status.responseDropCounter),
+	}
+}
@@ -0,0 +1,115 @@
+package pinger46
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+)
+
+type probeTarget struct {
+	id           TargetID
+	ipAddress    string
+	comment      string
+	binIPAddress net.IP
+	netIPAddr    *net.IPAddr
+	hostPort     string
+	isIPv6       bool
+	reqList      *tReqList
+
+	//stops sender of this target, nil until started
+	cancelFunc context.CancelFunc
+
+	probeCount *tProbeCount
+}
+
+type tProbeCount struct {
+	sync.Mutex
+	//probes waiting for a result or timeout
+	sent     int64
+	finished int64
+	//settled and received leave out the hops short of the target in trace mode
+	settled    int64
+	received   int64
+	isSendDone bool
+}
+
+type tReqList struct {
+	sync.Mutex
+	req [responseListNum]tReq
+}
+
+//oldestWaitingSeq must be called with lock held
+func (thisReqList *tReqList) oldestWaitingSeq() int {
+	seq := -1
+	sendTimeNanosec := int64(0)
+	for i := range thisReqList.req {
+		req := &thisReqList.req[i]
+		if req.state == reqStateWaiting && (seq < 0 || req.sendTimeNanosec < sendTimeNanosec) {
+			seq = req.seq
+			sendTimeNanosec = req.sendTimeNanosec
+		}
+	}
+
+	return seq
+}
+
+type tReqState uint8
+
+const (
+	reqStateNone = tReqState(iota)
+	reqStateWaiting
+	reqStateReceived
+	reqStateTimeout
+)
+
+type tReq struct {
+	seq             int
+	ttl             int
+	state           tReqState
+	sendTimeNanosec int64
+	timeouter       *time.Timer
+}
+
+type icmpResponse struct {
+	peer               net.Addr
+	targetID           TargetID
+	seq                int
+	resultType         IcmpResultType
+	receiveTimeNanosec int64
+}
+
+type sData struct {
+	sync.Mutex
+	Res   []int64
+	Index int64
+	//number of Res slots filled by real results, the rest are prefilled
+	filledNum int64
+
+	//RTT of each Res, -1 if none
+	Rtt []int64
+
+	//RFC 3550 interarrival jitter
+	jitterNanosec     float64
+	lastRttNanosec    int64
+	hasReceivedBefore bool
+
+	//for StatisticsWindowsSec, oldest first
+	samples []tSample
+}
+
+type tSample struct {
+	sendTimeNanosec int64
+	//-1 if lost
+	rttNanosec int64
+}
+
+type tICMPData struct {
+	SendTimeNanosec int64
+}
+
+type tUDPData struct {
+	ID              uint16
+	Seq             uint16
+	SendTimeNanosec int64
+}
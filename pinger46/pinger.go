@@ -0,0 +1,239 @@
+package pinger46
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/umenosuke/labelinglog"
+	"golang.org/x/net/icmp"
+)
+
+// Pinger is Pinger
+type Pinger struct {
+	config Config
+	logger *labelinglog.LabelingLogger
+
+	icmpID int
+
+	//guards targets, targetsOrder, conn.udp and the per target data maps
+	targetsLock  sync.RWMutex
+	targets      map[TargetID]probeTarget
+	targetsOrder []TargetID
+
+	isStarted struct {
+		sync.Mutex
+		flg bool
+	}
+	cancelFunc context.CancelFunc
+
+	//closed and replaced to wake up senders (interval changed, resumed)
+	senderWakeup struct {
+		sync.Mutex
+		ch chan struct{}
+	}
+
+	pause struct {
+		sync.Mutex
+		isPaused           bool
+		pausedNanosec      int64
+		totalPausedNanosec int64
+	}
+
+	//for targets added after start
+	running struct {
+		ctx context.Context
+		wg  *sync.WaitGroup
+	}
+
+	conn struct {
+		//guards v4TTL and the TTL option of v4
+		v4Lock sync.Mutex
+		v4     *icmp.PacketConn
+		//TTL of v4 when ttl 0 is requested, and the one set now
+		v4DefaultTTL int
+		v4TTL        int
+		v6           *icmp.PacketConn
+		udp          map[TargetID]*net.UDPConn
+	}
+
+	chIcmpResponse chan icmpResponse
+	chIcmpResult   chan IcmpResult
+
+	statisticsData struct {
+		targets map[TargetID]*sData
+
+		maxWindowNanosec int64
+	}
+
+	traceData struct {
+		targets map[TargetID]*tTraceData
+	}
+
+	stateData struct {
+		targets map[TargetID]*tStateData
+	}
+
+	status struct {
+		timeouterCounter  int64
+		resultDropCounter int64
+		//replies dropped before parsing, they end up as timeouts
+		responseDropCounter int64
+
+		resultSubscriberDropCounter      int64
+		pathChangeSubscriberDropCounter  int64
+		targetStateSubscriberDropCounter int64
+	}
+
+	chIcmpResultsSubscriber struct {
+		sync.Mutex
+		list []chan IcmpResult
+	}
+
+	chPathChangeSubscriber struct {
+		sync.Mutex
+		list []chan PathChange
+	}
+
+	chTargetStateSubscriber struct {
+		sync.Mutex
+		list []chan TargetStateChange
+	}
+
+	addIntervalVar struct {
+		sync.Mutex
+		lastExecutionTime int64
+	}
+
+	//closed when every target has sent Count probes and got all the results
+	completed struct {
+		once sync.Once
+		ch   chan struct{}
+	}
+}
+
+// New is create Pinger
+func New(icmpID int, pingerConfig Config) Pinger {
+	return Pinger{
+		config: pingerConfig,
+		logger: labelinglog.New("pinger "+strconv.Itoa(icmpID), os.Stderr),
+
+		icmpID:       icmpID,
+		targets:      make(map[TargetID]probeTarget),
+		targetsOrder: make([]TargetID, 0),
+
+		isStarted: struct {
+			sync.Mutex
+			flg bool
+		}{
+			flg: false,
+		},
+		cancelFunc: func() {},
+
+		senderWakeup: struct {
+			sync.Mutex
+			ch chan struct{}
+		}{
+			ch: make(chan struct{}),
+		},
+
+		chIcmpResponse: make(chan icmpResponse, chBufferSize),
+		chIcmpResult:   make(chan IcmpResult, chBufferSize),
+
+		statisticsData: struct {
+			targets map[TargetID]*sData
+
+			maxWindowNanosec int64
+		}{
+			targets:          make(map[TargetID]*sData),
+			maxWindowNanosec: maxWindowSec(pingerConfig.StatisticsWindowsSec) * int64(time.Second),
+		},
+
+		traceData: struct {
+			targets map[TargetID]*tTraceData
+		}{
+			targets: make(map[TargetID]*tTraceData),
+		},
+
+		stateData: struct {
+			targets map[TargetID]*tStateData
+		}{
+			targets: make(map[TargetID]*tStateData),
+		},
+
+		status: struct {
+			timeouterCounter  int64
+			resultDropCounter int64
+			//replies dropped before parsing, they end up as timeouts
+			responseDropCounter int64
+
+			resultSubscriberDropCounter      int64
+			pathChangeSubscriberDropCounter  int64
+			targetStateSubscriberDropCounter int64
+		}{
+			timeouterCounter:  0,
+			resultDropCounter: 0,
+
+			resultSubscriberDropCounter:      0,
+			pathChangeSubscriberDropCounter:  0,
+			targetStateSubscriberDropCounter: 0,
+		},
+
+		chIcmpResultsSubscriber: struct {
+			sync.Mutex
+			list []chan IcmpResult
+		}{
+			list: make([]chan IcmpResult, 0),
+		},
+
+		chPathChangeSubscriber: struct {
+			sync.Mutex
+			list []chan PathChange
+		}{
+			list: make([]chan PathChange, 0),
+		},
+
+		chTargetStateSubscriber: struct {
+			sync.Mutex
+			list []chan TargetStateChange
+		}{
+			list: make([]chan TargetStateChange, 0),
+		},
+
+		completed: struct {
+			once sync.Once
+			ch   chan struct{}
+		}{
+			ch: make(chan struct{}),
+		},
+	}
+}
+
+//ProbeType a
+type ProbeType uint8
+
+//ProbeType a
+const (
+	ProbeTypeICMP = ProbeType(iota)
+	ProbeTypeTCP
+	ProbeTypeUDP
+)
+
+//Mode a
+type Mode uint8
+
+//Mode a
+const (
+	ModePing = Mode(iota)
+	ModeTrace
+)
+
+//TargetID a
+type TargetID struct {
+	ProbeType ProbeType
+	BinIP     BinIPAddress
+	Port      uint16
+}
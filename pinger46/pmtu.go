@@ -0,0 +1,266 @@
+package pinger46
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const icmpEchoHeaderLen = 8
+
+//PathMTUConfig a
+type PathMTUConfig struct {
+	SourceIPAddress   string
+	SourceIPv6Address string
+	MinPayloadSize    int
+	MaxPayloadSize    int
+	TimeoutMillisec   int64
+	Attempts          int
+}
+
+//PathMTUResult a
+type PathMTUResult struct {
+	TargetBinIP BinIPAddress
+	//0 if no size passed
+	MaxPayloadSize      int
+	PathMTU             int
+	ProbeCount          int
+	FragmentationNeeded []FragmentationNeeded
+}
+
+//FragmentationNeeded a
+type FragmentationNeeded struct {
+	BinPeerIP   BinIPAddress
+	NextHopMTU  int
+	PayloadSize int
+}
+
+//DiscoverPathMTU binary searches the largest echo payload size which passes with DF set
+func DiscoverPathMTU(ctx context.Context, icmpID int, target string, config PathMTUConfig) (PathMTUResult, error) {
+	binIPAddress := net.ParseIP(target)
+	if binIPAddress == nil {
+		resolveIPAddress, err := net.ResolveIPAddr("ip", target)
+		if err != nil {
+			return PathMTUResult{}, errors.New("parseIP fail : " + err.Error())
+		}
+		binIPAddress = resolveIPAddress.IP
+	}
+	if config.MinPayloadSize < 0 || config.MaxPayloadSize < config.MinPayloadSize {
+		return PathMTUResult{}, errors.New("invalid payload size range")
+	}
+
+	res := PathMTUResult{
+		TargetBinIP:         NetIP2BinIPAddress(binIPAddress),
+		FragmentationNeeded: make([]FragmentationNeeded, 0),
+	}
+	isIPv6 := !res.TargetBinIP.IsIPv4()
+
+	var conn *icmp.PacketConn
+	var err error
+	headerLen := ipv4.HeaderLen + icmpEchoHeaderLen
+	if isIPv6 {
+		conn, err = icmp.ListenPacket("ip6:ipv6-icmp", config.SourceIPv6Address)
+		headerLen = ipv6.HeaderLen + icmpEchoHeaderLen
+	} else {
+		conn, err = icmp.ListenPacket("ip4:icmp", config.SourceIPAddress)
+	}
+	if err != nil {
+		return res, err
+	}
+	defer conn.Close()
+
+	var packetConn net.PacketConn
+	if isIPv6 {
+		setICMPv6Filter(conn)
+		packetConn = conn.IPv6PacketConn().PacketConn
+	} else {
+		packetConn = conn.IPv4PacketConn().PacketConn
+	}
+	syscallConn, ok := packetConn.(syscall.Conn)
+	if !ok {
+		return res, errors.New("unsupported conn")
+	}
+	rawConn, err := syscallConn.SyscallConn()
+	if err != nil {
+		return res, err
+	}
+	//DF set, and ignore the PMTU cached by the kernel
+	if err := setDontFragmentProbe(rawConn, isIPv6); err != nil {
+		return res, err
+	}
+
+	prober := tPathMTUProber{
+		conn:    conn,
+		isIPv6:  isIPv6,
+		icmpID:  icmpID,
+		dst:     &net.IPAddr{IP: binIPAddress},
+		timeout: time.Duration(config.TimeoutMillisec) * time.Millisecond,
+		result:  &res,
+	}
+
+	attempts := config.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	//passed <= answer < failed
+	passed := config.MinPayloadSize - 1
+	failed := config.MaxPayloadSize + 1
+	for passed+1 < failed {
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		default:
+		}
+
+		size := (passed + failed) / 2
+		ok := false
+		for i := 0; i < attempts && !ok; i++ {
+			ok, err = prober.probe(ctx, size)
+			if err != nil {
+				return res, err
+			}
+			if prober.lastNextHopMTU > 0 {
+				//explicit too big, no need to retry
+				break
+			}
+		}
+		if ok {
+			passed = size
+		} else {
+			failed = size
+			if nextHopMTU := prober.lastNextHopMTU; nextHopMTU > 0 && nextHopMTU-headerLen+1 < failed && nextHopMTU-headerLen > passed {
+				failed = nextHopMTU - headerLen + 1
+			}
+		}
+	}
+
+	if passed >= config.MinPayloadSize && passed >= 0 {
+		res.MaxPayloadSize = passed
+		res.PathMTU = passed + headerLen
+	}
+
+	return res, nil
+}
+
+type tPathMTUProber struct {
+	conn    *icmp.PacketConn
+	isIPv6  bool
+	icmpID  int
+	dst     *net.IPAddr
+	timeout time.Duration
+	seq     int
+	result  *PathMTUResult
+
+	lastNextHopMTU int
+}
+
+func (thisProber *tPathMTUProber) probe(ctx context.Context, size int) (bool, error) {
+	thisProber.seq = (thisProber.seq + 1) & 0xffff
+	thisProber.lastNextHopMTU = 0
+	thisProber.result.ProbeCount++
+
+	wm := icmp.Message{
+		Code: 0,
+		Body: &icmp.Echo{
+			ID:   thisProber.icmpID,
+			Seq:  thisProber.seq,
+			Data: make([]byte, size),
+		},
+	}
+	proto := protocolICMP
+	if thisProber.isIPv6 {
+		wm.Type = ipv6.ICMPTypeEchoRequest
+		proto = protocolIPv6ICMP
+	} else {
+		wm.Type = ipv4.ICMPTypeEcho
+	}
+	wb, err := wm.Marshal(nil)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := thisProber.conn.WriteTo(wb, thisProber.dst); err != nil {
+		if errors.Is(err, syscall.EMSGSIZE) {
+			//larger than the local interface MTU
+			return false, nil
+		}
+		return false, err
+	}
+
+	deadline := time.Now().Add(thisProber.timeout)
+	rb := make([]byte, responseMTU)
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		default:
+		}
+		if !time.Now().Before(deadline) {
+			return false, nil
+		}
+
+		thisProber.conn.SetReadDeadline(deadline)
+		n, peer, err := thisProber.conn.ReadFrom(rb)
+		if err != nil {
+			if neterr, ok := err.(net.Error); ok && neterr.Timeout() {
+				return false, nil
+			}
+			return false, err
+		}
+
+		icmpMessage, err := icmp.ParseMessage(proto, rb[:n])
+		if err != nil {
+			continue
+		}
+
+		switch icmpMessage.Type {
+		case ipv4.ICMPTypeEchoReply, ipv6.ICMPTypeEchoReply:
+			body, ok := icmpMessage.Body.(*icmp.Echo)
+			if !ok || body.ID != thisProber.icmpID || body.Seq != thisProber.seq {
+				continue
+			}
+			return true, nil
+		case ipv4.ICMPTypeDestinationUnreachable, ipv6.ICMPTypePacketTooBig:
+			if n < 8 {
+				continue
+			}
+			resultType, _ := icmpErrorResultType(icmpMessage.Type, icmpMessage.Code)
+			if resultType != IcmpResultTypeFragmentationNeeded {
+				continue
+			}
+			protocol, _, header, ok := parseEmbedded(rb[8:n], thisProber.isIPv6)
+			if !ok || (protocol != protocolICMP && protocol != protocolIPv6ICMP) {
+				continue
+			}
+			if int(binary.BigEndian.Uint16(header[4:6])) != thisProber.icmpID || int(binary.BigEndian.Uint16(header[6:8])) != thisProber.seq {
+				continue
+			}
+
+			nextHopMTU := 0
+			if thisProber.isIPv6 {
+				nextHopMTU = int(binary.BigEndian.Uint32(rb[4:8]))
+			} else {
+				nextHopMTU = int(binary.BigEndian.Uint16(rb[6:8]))
+			}
+			fragmentationNeeded := FragmentationNeeded{
+				NextHopMTU:  nextHopMTU,
+				PayloadSize: size,
+			}
+			if peerIPAddr, ok := peer.(*net.IPAddr); ok {
+				fragmentationNeeded.BinPeerIP = NetIP2BinIPAddress(peerIPAddr.IP)
+			}
+			thisProber.result.FragmentationNeeded = append(thisProber.result.FragmentationNeeded, fragmentationNeeded)
+			thisProber.lastNextHopMTU = nextHopMTU
+			return false, nil
+		default:
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package pinger46
+
+import (
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+// AddTarget is AddTarget
+func (thisPinger *Pinger) AddTarget(ipAddress string, comment string) error {
+	targetID, err := ResolveTarget(ipAddress)
+	if err != nil {
+		thisPinger.logger.Log(labelinglog.FlgWarn, err.Error())
+		return err
+	}
+
+	return thisPinger.AddResolvedTarget(targetID, ipAddress, comment)
+}
+
+//AddResolvedTarget adds targetID from ResolveTarget(ipAddress) without resolving ipAddress again
+func (thisPinger *Pinger) AddResolvedTarget(targetID TargetID, ipAddress string, comment string) error {
+	thisPinger.isStarted.Lock()
+	defer thisPinger.isStarted.Unlock()
+
+	binIPAddress := net.IP(BinIPAddress2Bytes(targetID.BinIP))
+
+	thisPinger.targetsLock.Lock()
+	defer thisPinger.targetsLock.Unlock()
+
+	if _, ok := thisPinger.targets[targetID]; ok {
+		msg := "add skip already added : " + ipAddress
+		thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+		return errors.New(msg)
+	}
+	if thisPinger.config.MaxTargets > 0 && int64(len(thisPinger.targets)) >= thisPinger.config.MaxTargets {
+		msg := "add skip too many targets, max " + strconv.FormatInt(thisPinger.config.MaxTargets, 10) + " : " + ipAddress
+		thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+		return errors.New(msg)
+	}
+
+	target := probeTarget{
+		id:           targetID,
+		ipAddress:    ipAddress,
+		comment:      comment,
+		binIPAddress: binIPAddress,
+		netIPAddr:    &net.IPAddr{IP: binIPAddress},
+		hostPort:     net.JoinHostPort(binIPAddress.String(), strconv.Itoa(int(targetID.Port))),
+		isIPv6:       !targetID.BinIP.IsIPv4(),
+		reqList:      &tReqList{},
+	}
+	thisPinger.registerTarget(target)
+
+	if thisPinger.running.ctx != nil {
+		if err := thisPinger.startTarget(targetID); err != nil {
+			thisPinger.unregisterTarget(targetID)
+			msg := "start target fail : " + err.Error()
+			thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+			return errors.New(msg)
+		}
+	}
+
+	return nil
+}
+
+//ResolveTarget a
+func ResolveTarget(ipAddress string) (TargetID, error) {
+	targetID, _, err := resolveTarget(ipAddress)
+	return targetID, err
+}
+
+func resolveTarget(ipAddress string) (TargetID, net.IP, error) {
+	probeType, host, port, err := parseTarget(ipAddress)
+	if err != nil {
+		return TargetID{}, nil, errors.New("parse target fail : " + err.Error())
+	}
+
+	binIPAddress := net.ParseIP(host)
+	if binIPAddress == nil {
+		resolveIPAddress, err := net.ResolveIPAddr("ip", host)
+		if err != nil {
+			return TargetID{}, nil, errors.New("parseIP fail : " + err.Error())
+		}
+
+		binIPAddress = resolveIPAddress.IP
+	}
+
+	if binIPAddress == nil {
+		return TargetID{}, nil, errors.New("parseIP fail : " + ipAddress)
+	}
+
+	return TargetID{
+		ProbeType: probeType,
+		BinIP:     NetIP2BinIPAddress(binIPAddress),
+		Port:      port,
+	}, binIPAddress, nil
+}
+
+func parseTarget(target string) (ProbeType, string, uint16, error) {
+	probeType := ProbeTypeICMP
+	hostPort := ""
+	if strings.HasPrefix(target, tcpTargetPrefix) {
+		probeType = ProbeTypeTCP
+		hostPort = strings.TrimPrefix(target, tcpTargetPrefix)
+	} else if strings.HasPrefix(target, udpTargetPrefix) {
+		probeType = ProbeTypeUDP
+		hostPort = strings.TrimPrefix(target, udpTargetPrefix)
+	} else {
+		return probeType, target, 0, nil
+	}
+
+	host, port, err := splitHostPort(hostPort)
+	return probeType, host, port, err
+}
+
+func splitHostPort(hostPort string) (string, uint16, error) {
+	host, portStr, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || port == 0 {
+		return "", 0, errors.New("invalid port : " + portStr)
+	}
+
+	return host, uint16(port), nil
+}
+
+//SetLogEnableLevel a
+func (thisPinger *Pinger) SetLogEnableLevel(targetLevelFlgs labelinglog.LogLevel) error {
+	thisPinger.isStarted.Lock()
+	defer thisPinger.isStarted.Unlock()
+	if thisPinger.isStarted.flg {
+		msg := "Pinger has already started"
+		thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+		return errors.New(msg)
+	}
+
+	thisPinger.logger.SetEnableLevel(targetLevelFlgs)
+	return nil
+}
+
+//SetLogWriter a
+func (thisPinger *Pinger) SetLogWriter(targetLevelFlgs labelinglog.LogLevel, writer io.Writer) error {
+	thisPinger.isStarted.Lock()
+	defer thisPinger.isStarted.Unlock()
+	if thisPinger.isStarted.flg {
+		msg := "Pinger has already started"
+		thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+		return errors.New(msg)
+	}
+
+	thisPinger.logger.SetIoWriter(targetLevelFlgs, writer)
+	return nil
+}
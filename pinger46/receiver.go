@@ -0,0 +1,311 @@
+package pinger46
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+const protocolICMP = 1
+const protocolIPv6ICMP = 58
+const protocolTCP = 6
+const protocolUDP = 17
+
+//not defined in x/net/ipv4 (deprecated by RFC 6633)
+const icmpTypeSourceQuench = ipv4.ICMPType(4)
+
+func setICMPv6Filter(conn *icmp.PacketConn) {
+	var f ipv6.ICMPFilter
+	f.SetAll(true)
+	f.Accept(ipv6.ICMPTypeEchoReply)
+	f.Accept(ipv6.ICMPTypeTimeExceeded)
+	f.Accept(ipv6.ICMPTypeDestinationUnreachable)
+	f.Accept(ipv6.ICMPTypePacketTooBig)
+	f.Accept(ipv6.ICMPTypeParameterProblem)
+	conn.IPv6PacketConn().SetICMPFilter(&f)
+}
+
+func (thisPinger *Pinger) listener(ctx context.Context, wg *sync.WaitGroup, conn *icmp.PacketConn, isIPv6 bool) {
+	defer wg.Done()
+	defer thisPinger.logger.Log(labelinglog.FlgDebug, "finish")
+
+	defer thisPinger.cancelFunc()
+
+	proto := protocolICMP
+	if isIPv6 {
+		proto = protocolIPv6ICMP
+	}
+
+	rb := make([]byte, responseMTU)
+	for {
+		select {
+		case <-ctx.Done():
+			thisPinger.logger.Log(labelinglog.FlgDebug, "stop request received")
+			return
+		default:
+		}
+
+		conn.SetReadDeadline(time.Now().Add(1 * time.Second))
+		n, peer, err := conn.ReadFrom(rb)
+		if err != nil {
+			if neterr, ok := err.(*net.OpError); ok {
+				if neterr.Timeout() {
+					continue
+				} else {
+					thisPinger.logger.Log(labelinglog.FlgError, err.Error())
+					return
+				}
+			} else {
+				thisPinger.logger.Log(labelinglog.FlgError, err.Error())
+				return
+			}
+		}
+		nowNanosec := time.Now().UnixNano()
+
+		icmpMessage, err := icmp.ParseMessage(proto, rb[:n])
+		if err != nil {
+			continue
+		}
+
+		var res icmpResponse
+		switch icmpMessage.Type {
+		case ipv4.ICMPTypeEchoReply, ipv6.ICMPTypeEchoReply:
+			body, ok := icmpMessage.Body.(*icmp.Echo)
+			if !ok || body.ID != thisPinger.icmpID {
+				continue
+			}
+			peerIPAddr, ok := peer.(*net.IPAddr)
+			if !ok {
+				continue
+			}
+			res = icmpResponse{
+				peer:       peer,
+				targetID:   TargetID{ProbeType: ProbeTypeICMP, BinIP: NetIP2BinIPAddress(peerIPAddr.IP)},
+				seq:        body.Seq,
+				resultType: IcmpResultTypeReceive,
+			}
+		default:
+			resultType, ok := icmpErrorResultType(icmpMessage.Type, icmpMessage.Code)
+			if !ok || n < 8 {
+				continue
+			}
+			targetID, seq, ok := thisPinger.matchEmbedded(rb[8:n], isIPv6)
+			if !ok {
+				continue
+			}
+			res = icmpResponse{
+				peer:       peer,
+				targetID:   targetID,
+				seq:        seq,
+				resultType: resultType,
+			}
+		}
+		res.receiveTimeNanosec = nowNanosec
+
+		thisPinger.sendResponse(res)
+	}
+}
+
+//sendResponse a reply that does not fit is counted and slows the pinger down, it will be reported as timeout
+func (thisPinger *Pinger) sendResponse(res icmpResponse) {
+	select {
+	case thisPinger.chIcmpResponse <- res:
+	default:
+		thisPinger.logger.Log(labelinglog.FlgWarn, "busy responseParser")
+		atomic.AddInt64(&thisPinger.status.responseDropCounter, 1)
+		thisPinger.addInterval()
+	}
+}
+
+//icmpErrorResultType returns result type of ICMP error message
+func icmpErrorResultType(icmpType icmp.Type, code int) (IcmpResultType, bool) {
+	switch icmpType {
+	case ipv4.ICMPTypeTimeExceeded, ipv6.ICMPTypeTimeExceeded:
+		return IcmpResultTypeTTLExceeded, true
+	case ipv4.ICMPTypeDestinationUnreachable:
+		switch code {
+		case 0, 6, 11:
+			return IcmpResultTypeNetUnreachable, true
+		case 1, 7, 12:
+			return IcmpResultTypeHostUnreachable, true
+		case 2:
+			return IcmpResultTypeProtocolUnreachable, true
+		case 3:
+			return IcmpResultTypePortUnreachable, true
+		case 4:
+			return IcmpResultTypeFragmentationNeeded, true
+		case 9, 10, 13:
+			return IcmpResultTypeAdminProhibited, true
+		default:
+			return IcmpResultTypeDestinationUnreachable, true
+		}
+	case ipv6.ICMPTypeDestinationUnreachable:
+		switch code {
+		case 0:
+			return IcmpResultTypeNetUnreachable, true
+		case 1, 5, 6:
+			return IcmpResultTypeAdminProhibited, true
+		case 3:
+			return IcmpResultTypeHostUnreachable, true
+		case 4:
+			return IcmpResultTypePortUnreachable, true
+		default:
+			return IcmpResultTypeDestinationUnreachable, true
+		}
+	case ipv6.ICMPTypePacketTooBig:
+		return IcmpResultTypeFragmentationNeeded, true
+	case icmpTypeSourceQuench:
+		return IcmpResultTypeSourceQuench, true
+	case ipv4.ICMPTypeRedirect:
+		return IcmpResultTypeRedirect, true
+	case ipv4.ICMPTypeParameterProblem, ipv6.ICMPTypeParameterProblem:
+		return IcmpResultTypeParameterProblem, true
+	default:
+		return IcmpResultUnknown, false
+	}
+}
+
+//matchEmbedded returns target and seq of the original probe in ICMP error message
+//seq is -1 if it can not be determined
+func (thisPinger *Pinger) matchEmbedded(data []byte, isIPv6 bool) (TargetID, int, bool) {
+	protocol, dst, header, ok := parseEmbedded(data, isIPv6)
+	if !ok {
+		return TargetID{}, 0, false
+	}
+
+	switch protocol {
+	case protocolICMP, protocolIPv6ICMP:
+		if header[0] != byte(ipv4.ICMPTypeEcho) && header[0] != byte(ipv6.ICMPTypeEchoRequest) {
+			return TargetID{}, 0, false
+		}
+		if int(binary.BigEndian.Uint16(header[4:6])) != thisPinger.icmpID {
+			return TargetID{}, 0, false
+		}
+		return TargetID{ProbeType: ProbeTypeICMP, BinIP: dst}, int(binary.BigEndian.Uint16(header[6:8])), true
+	case protocolUDP:
+		targetID := TargetID{ProbeType: ProbeTypeUDP, BinIP: dst, Port: binary.BigEndian.Uint16(header[2:4])}
+		udpConn, ok := thisPinger.getUDPConn(targetID)
+		if !ok || udpConn.LocalAddr().(*net.UDPAddr).Port != int(binary.BigEndian.Uint16(header[0:2])) {
+			return TargetID{}, 0, false
+		}
+		return targetID, parseUDPData(header[8:], thisPinger.icmpID), true
+	case protocolTCP:
+		targetID := TargetID{ProbeType: ProbeTypeTCP, BinIP: dst, Port: binary.BigEndian.Uint16(header[2:4])}
+		if _, ok := thisPinger.getTarget(targetID); !ok {
+			return TargetID{}, 0, false
+		}
+		return targetID, -1, true
+	default:
+		return TargetID{}, 0, false
+	}
+}
+
+//parseEmbedded returns protocol, destination and the first 8 bytes or more of upper layer of the original datagram in ICMP error message
+func parseEmbedded(data []byte, isIPv6 bool) (byte, BinIPAddress, []byte, bool) {
+	if isIPv6 {
+		if len(data) < ipv6.HeaderLen+8 {
+			return 0, BinIPAddress{}, nil, false
+		}
+		return data[6], NetIP2BinIPAddress(net.IP(data[24:40])), data[ipv6.HeaderLen:], true
+	}
+
+	if len(data) < ipv4.HeaderLen {
+		return 0, BinIPAddress{}, nil, false
+	}
+	headerLen := int(data[0]&0x0f) * 4
+	if len(data) < headerLen+8 {
+		return 0, BinIPAddress{}, nil, false
+	}
+	return data[9], NetIP2BinIPAddress(net.IP(data[16:20])), data[headerLen:], true
+}
+
+func (thisPinger *Pinger) responseParser(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer thisPinger.logger.Log(labelinglog.FlgDebug, "finish")
+
+	defer thisPinger.cancelFunc()
+
+	for {
+		select {
+		case <-ctx.Done():
+			thisPinger.logger.Log(labelinglog.FlgDebug, "stop request received")
+			return
+		case res := <-thisPinger.chIcmpResponse:
+			thisPinger.recvRes(res)
+		}
+	}
+}
+
+func (thisPinger *Pinger) recvRes(res icmpResponse) {
+	target, ok := thisPinger.getTarget(res.targetID)
+	if !ok {
+		return
+	}
+
+	target.reqList.Lock()
+	if res.seq < 0 {
+		//no seq in the response, match with the oldest waiting request
+		res.seq = target.reqList.oldestWaitingSeq()
+		if res.seq < 0 {
+			target.reqList.Unlock()
+			return
+		}
+	}
+	req := &target.reqList.req[res.seq&(responseListNum-1)]
+	if req.seq != res.seq {
+		target.reqList.Unlock()
+		return
+	}
+	state := req.state
+	ttl := req.ttl
+	sendTimeNanosec := req.sendTimeNanosec
+	switch state {
+	case reqStateWaiting:
+		//redirect is informational, the probe is still forwarded
+		if res.resultType != IcmpResultTypeRedirect {
+			req.state = reqStateReceived
+			if req.timeouter != nil && req.timeouter.Stop() {
+				atomic.AddInt64(&thisPinger.status.timeouterCounter, -1)
+			}
+		}
+	case reqStateTimeout:
+		if res.resultType == IcmpResultTypeReceive {
+			req.state = reqStateReceived
+		}
+	}
+	target.reqList.Unlock()
+
+	result := IcmpResult{
+		ResultType:             res.resultType,
+		IcmpTargetID:           res.targetID,
+		Seq:                    res.seq,
+		TTL:                    ttl,
+		SendTimeUnixNanosec:    sendTimeNanosec,
+		ReceiveTimeUnixNanosec: res.receiveTimeNanosec,
+	}
+	if res.resultType != IcmpResultTypeReceive {
+		if peerIPAddr, ok := res.peer.(*net.IPAddr); ok {
+			result.BinPeerIP = NetIP2BinIPAddress(peerIPAddr.IP)
+		}
+	}
+
+	switch state {
+	case reqStateWaiting:
+		thisPinger.sendResult(result)
+	case reqStateTimeout:
+		if res.resultType == IcmpResultTypeReceive {
+			result.ResultType = IcmpResultTypeReceiveAfterTimeout
+			thisPinger.sendResult(result)
+		}
+	default:
+	}
+}
@@ -0,0 +1,129 @@
+package pinger46
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+)
+
+func embeddedIPv4(protocol byte, dst string, optionsLen int, upper []byte) []byte {
+	header := make([]byte, 20+optionsLen)
+	header[0] = 0x40 | byte((20+optionsLen)/4)
+	header[9] = protocol
+	copy(header[16:20], net.ParseIP(dst).To4())
+	return append(header, upper...)
+}
+
+func embeddedIPv6(nextHeader byte, dst string, upper []byte) []byte {
+	header := make([]byte, 40)
+	header[0] = 0x60
+	header[6] = nextHeader
+	copy(header[24:40], net.ParseIP(dst).To16())
+	return append(header, upper...)
+}
+
+func echoHeader(icmpType byte, id int, seq int) []byte {
+	header := make([]byte, 8)
+	header[0] = icmpType
+	binary.BigEndian.PutUint16(header[4:6], uint16(id))
+	binary.BigEndian.PutUint16(header[6:8], uint16(seq))
+	return header
+}
+
+func tcpHeader(srcPort uint16, dstPort uint16) []byte {
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint16(header[0:2], srcPort)
+	binary.BigEndian.PutUint16(header[2:4], dstPort)
+	return header
+}
+
+func TestParseEmbedded(t *testing.T) {
+	echo := echoHeader(8, 1, 2)
+
+	tests := []struct {
+		name         string
+		data         []byte
+		isIPv6       bool
+		wantOk       bool
+		wantProtocol byte
+		wantDst      string
+		wantUpper    []byte
+	}{
+		{"ipv4", embeddedIPv4(protocolICMP, "10.0.0.1", 0, echo), false, true, protocolICMP, "10.0.0.1", echo},
+		{"ipv4 with options", embeddedIPv4(protocolUDP, "10.0.0.2", 8, echo), false, true, protocolUDP, "10.0.0.2", echo},
+		{"ipv4 with more than 8 bytes", embeddedIPv4(protocolICMP, "10.0.0.1", 0, append(echo, 0xff, 0xff)), false, true, protocolICMP, "10.0.0.1", append(echo, 0xff, 0xff)},
+		{"ipv4 upper layer too short", embeddedIPv4(protocolICMP, "10.0.0.1", 0, echo[:7]), false, false, 0, "", nil},
+		{"ipv4 options cut off", embeddedIPv4(protocolICMP, "10.0.0.1", 8, echo)[:24], false, false, 0, "", nil},
+		{"ipv4 header too short", make([]byte, 19), false, false, 0, "", nil},
+		{"ipv6", embeddedIPv6(protocolIPv6ICMP, "fd00::1", echo), true, true, protocolIPv6ICMP, "fd00::1", echo},
+		{"ipv6 upper layer too short", embeddedIPv6(protocolIPv6ICMP, "fd00::1", echo[:7]), true, false, 0, "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protocol, dst, upper, ok := parseEmbedded(tt.data, tt.isIPv6)
+			if ok != tt.wantOk {
+				t.Fatalf("parseEmbedded() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			if protocol != tt.wantProtocol {
+				t.Errorf("protocol = %d, want %d", protocol, tt.wantProtocol)
+			}
+			if want := NetIP2BinIPAddress(net.ParseIP(tt.wantDst)); dst != want {
+				t.Errorf("dst = %s, want %s", BinIPAddress2String(dst), tt.wantDst)
+			}
+			if string(upper) != string(tt.wantUpper) {
+				t.Errorf("upper = %v, want %v", upper, tt.wantUpper)
+			}
+		})
+	}
+}
+
+func TestMatchEmbedded(t *testing.T) {
+	pinger := New(1234, DefaultConfig())
+	if err := pinger.AddTarget("tcp://10.0.0.3:443", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		data         []byte
+		isIPv6       bool
+		wantOk       bool
+		wantTargetID string
+		wantSeq      int
+	}{
+		{"ipv4 echo", embeddedIPv4(protocolICMP, "10.0.0.1", 0, echoHeader(8, 1234, 7)), false, true, "10.0.0.1", 7},
+		{"ipv6 echo", embeddedIPv6(protocolIPv6ICMP, "fd00::1", echoHeader(128, 1234, 9)), true, true, "fd00::1", 9},
+		{"other icmp id", embeddedIPv4(protocolICMP, "10.0.0.1", 0, echoHeader(8, 4321, 7)), false, false, "", 0},
+		{"not an echo request", embeddedIPv4(protocolICMP, "10.0.0.1", 0, echoHeader(0, 1234, 7)), false, false, "", 0},
+		{"known tcp target", embeddedIPv4(protocolTCP, "10.0.0.3", 0, tcpHeader(50000, 443)), false, true, "tcp://10.0.0.3:443", -1},
+		{"unknown tcp target", embeddedIPv4(protocolTCP, "10.0.0.3", 0, tcpHeader(50000, 80)), false, false, "", 0},
+		{"unknown protocol", embeddedIPv4(47, "10.0.0.1", 0, make([]byte, 8)), false, false, "", 0},
+		{"truncated", embeddedIPv4(protocolICMP, "10.0.0.1", 0, nil), false, false, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetID, seq, ok := pinger.matchEmbedded(tt.data, tt.isIPv6)
+			if ok != tt.wantOk {
+				t.Fatalf("matchEmbedded() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			wantTargetID, err := ResolveTarget(tt.wantTargetID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if targetID != wantTargetID {
+				t.Errorf("targetID = %+v, want %+v", targetID, wantTargetID)
+			}
+			if seq != tt.wantSeq {
+				t.Errorf("seq = %d, want %d", seq, tt.wantSeq)
+			}
+		})
+	}
+}
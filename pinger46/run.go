@@ -1,10 +1,12 @@
-package pinger4
+package pinger46
 
 import (
 	"context"
 	"errors"
+	"net"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/umenosuke/labelinglog"
@@ -26,29 +28,29 @@ func (thisPinger *Pinger) Run(ctx context.Context) error {
 	defer thisPinger.logger.Log(labelinglog.FlgNotice, "finish Pinger")
 	thisPinger.logger.Log(labelinglog.FlgNotice, "start Pinger")
 
-	timeoutLimit := thisPinger.config.IntervalMillisec * responseListNum / 2
-	if timeoutLimit < thisPinger.config.TimeoutMillisec {
-		thisPinger.logger.Log(labelinglog.FlgWarn, "timeout too long, change timeout ["+strconv.FormatInt(thisPinger.config.TimeoutMillisec, 10)+" to "+strconv.FormatInt(timeoutLimit, 10)+"]")
-		thisPinger.config.TimeoutMillisec = timeoutLimit
+	if len(thisPinger.GetTargetsOrder()) == 0 {
+		msg := "target IP list is empty"
+		thisPinger.logger.Log(labelinglog.FlgError, msg)
+		return errors.New(msg)
 	}
 
+	atomic.StoreInt64(&thisPinger.config.TimeoutMillisec, thisPinger.limitTimeout(thisPinger.getIntervalMillisec(), thisPinger.getTimeoutMillisec()))
+
 	childCtx, childCtxCancel := context.WithCancel(context.Background())
 	defer childCtxCancel()
 	thisPinger.cancelFunc = childCtxCancel
 
 	wgChild := sync.WaitGroup{}
+	defer thisPinger.closeConn()
+
 	{
 		thisPinger.logger.Log(labelinglog.FlgDebug, "start statistics")
 		wgChild.Add(1)
 		go thisPinger.statistics(childCtx, &wgChild)
 
-		thisPinger.logger.Log(labelinglog.FlgDebug, "start listener")
-		wgChild.Add(1)
-		go thisPinger.listener(childCtx, &wgChild)
-
-		thisPinger.logger.Log(labelinglog.FlgDebug, "start broker")
+		thisPinger.logger.Log(labelinglog.FlgDebug, "start responseParser")
 		wgChild.Add(1)
-		go thisPinger.broker(childCtx, &wgChild)
+		go thisPinger.responseParser(childCtx, &wgChild)
 	}
 
 	if thisPinger.config.DebugEnable {
@@ -56,15 +58,38 @@ func (thisPinger *Pinger) Run(ctx context.Context) error {
 		go thisPinger.debugStatus(childCtx, &wgChild)
 	}
 
-	resError := error(nil)
-	select {
-	case <-ctx.Done():
-		thisPinger.logger.Log(labelinglog.FlgDebug, "stop request from parent")
-	case <-childCtx.Done():
-		thisPinger.logger.Log(labelinglog.FlgDebug, "stop request from child")
-		msg := "may be fatal error (´・ω・`)"
-		thisPinger.logger.Log(labelinglog.FlgError, msg)
-		resError = errors.New(msg)
+	resError := (func() error {
+		thisPinger.targetsLock.Lock()
+		defer thisPinger.targetsLock.Unlock()
+
+		//targets added after this are started by AddTarget
+		thisPinger.running.ctx = childCtx
+		thisPinger.running.wg = &wgChild
+
+		thisPinger.conn.udp = make(map[TargetID]*net.UDPConn)
+		thisPinger.logger.Log(labelinglog.FlgDebug, "start sendInterval")
+		for _, targetID := range thisPinger.targetsOrder {
+			if err := thisPinger.startTarget(targetID); err != nil {
+				thisPinger.logger.Log(labelinglog.FlgError, err.Error())
+				return err
+			}
+		}
+
+		return nil
+	})()
+
+	if resError == nil {
+		select {
+		case <-ctx.Done():
+			thisPinger.logger.Log(labelinglog.FlgDebug, "stop request from parent")
+		case <-thisPinger.completed.ch:
+			thisPinger.logger.Log(labelinglog.FlgNotice, "all probes completed")
+		case <-childCtx.Done():
+			thisPinger.logger.Log(labelinglog.FlgDebug, "stop request from child")
+			msg := "may be fatal error (´・ω・`)"
+			thisPinger.logger.Log(labelinglog.FlgError, msg)
+			resError = errors.New(msg)
+		}
 	}
 
 	thisPinger.logger.Log(labelinglog.FlgDebug, "stop request to all chlid")
@@ -89,10 +114,26 @@ func (thisPinger *Pinger) Run(ctx context.Context) error {
 			}
 		}
 
+		thisPinger.stopTimeouters()
+
 		thisPinger.logger.Log(labelinglog.FlgDebug, "chIcmpResponse        "+strconv.Itoa(len(thisPinger.chIcmpResponse)))
-		thisPinger.logger.Log(labelinglog.FlgDebug, "chIcmpResponseTimeout "+strconv.Itoa(len(thisPinger.chIcmpResponseTimeout)))
 		thisPinger.logger.Log(labelinglog.FlgDebug, "chIcmpResult          "+strconv.Itoa(len(thisPinger.chIcmpResult)))
 	}
 
 	return resError
 }
+
+func (thisPinger *Pinger) closeConn() {
+	thisPinger.targetsLock.Lock()
+	defer thisPinger.targetsLock.Unlock()
+
+	if thisPinger.conn.v4 != nil {
+		thisPinger.conn.v4.Close()
+	}
+	if thisPinger.conn.v6 != nil {
+		thisPinger.conn.v6.Close()
+	}
+	for _, conn := range thisPinger.conn.udp {
+		conn.Close()
+	}
+}
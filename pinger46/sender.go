@@ -0,0 +1,241 @@
+package pinger46
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+func (thisPinger *Pinger) sendInterval(ctx context.Context, wg *sync.WaitGroup, target probeTarget) {
+	defer wg.Done()
+	defer thisPinger.logger.Log(labelinglog.FlgDebug, "("+target.ipAddress+")"+" finish")
+
+	if thisPinger.config.StartSendIcmpSmoothing {
+		select {
+		case <-ctx.Done():
+			thisPinger.logger.Log(labelinglog.FlgDebug, "("+target.ipAddress+") "+"stop request received")
+			return
+		case <-time.After(time.Duration(rand.Int63n(thisPinger.getIntervalMillisec())) * time.Millisecond):
+		}
+	}
+
+	intervalMillisec := thisPinger.getIntervalMillisec()
+	ticker := time.NewTicker(time.Duration(intervalMillisec) * time.Millisecond)
+	defer ticker.Stop()
+
+	seq := 0
+	sentRounds := int64(0)
+	for {
+		chSenderWakeup := thisPinger.getChSenderWakeup()
+		if !thisPinger.isPaused() {
+			if traceData, ok := thisPinger.getTraceData(target.id); ok {
+				reachedTTL := traceData.getReachedTTL()
+				for ttl := 1; ttl <= reachedTTL; ttl++ {
+					if err := thisPinger.send(ctx, target, seq, ttl); err != nil {
+						thisPinger.logger.Log(labelinglog.FlgWarn, "("+target.ipAddress+") "+err.Error())
+					}
+					seq = (seq + 1) & 0xffff
+				}
+			} else {
+				if err := thisPinger.send(ctx, target, seq, 0); err != nil {
+					thisPinger.logger.Log(labelinglog.FlgWarn, "("+target.ipAddress+") "+err.Error())
+				}
+				seq = (seq + 1) & 0xffff
+			}
+			sentRounds++
+		}
+
+		if thisPinger.config.Count > 0 && sentRounds >= thisPinger.config.Count {
+			thisPinger.logger.Log(labelinglog.FlgDebug, "("+target.ipAddress+")"+" sent all probes")
+			thisPinger.finishSending(target)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			thisPinger.logger.Log(labelinglog.FlgDebug, "("+target.ipAddress+")"+" stop request received")
+			return
+		case <-ticker.C:
+		case <-chSenderWakeup:
+		}
+
+		if newIntervalMillisec := thisPinger.getIntervalMillisec(); newIntervalMillisec != intervalMillisec {
+			intervalMillisec = newIntervalMillisec
+			ticker.Reset(time.Duration(intervalMillisec) * time.Millisecond)
+		}
+	}
+}
+
+//send ttl 0 is system default
+func (thisPinger *Pinger) send(ctx context.Context, target probeTarget, seq int, ttl int) error {
+	switch target.id.ProbeType {
+	case ProbeTypeTCP:
+		return thisPinger.sendTCP(ctx, target, seq)
+	case ProbeTypeUDP:
+		return thisPinger.sendUDP(ctx, target, seq, ttl)
+	default:
+		return thisPinger.sendIcmp(ctx, target, seq, ttl)
+	}
+}
+
+func (thisPinger *Pinger) sendIcmp(ctx context.Context, target probeTarget, seq int, ttl int) error {
+	nowNanosec := time.Now().UnixNano()
+
+	wmbd := bytes.NewBuffer(make([]byte, 0, 8))
+	binary.Write(wmbd, binary.LittleEndian, &tICMPData{
+		SendTimeNanosec: nowNanosec,
+	})
+
+	wm := icmp.Message{
+		Code: 0,
+		Body: &icmp.Echo{
+			ID:   thisPinger.icmpID,
+			Seq:  seq,
+			Data: thisPinger.payload(wmbd.Bytes()),
+		},
+	}
+	if target.isIPv6 {
+		wm.Type = ipv6.ICMPTypeEchoRequest
+	} else {
+		wm.Type = ipv4.ICMPTypeEcho
+	}
+	wb, err := wm.Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	thisPinger.setTimeouter(ctx, target, seq, ttl, nowNanosec)
+
+	if target.isIPv6 {
+		var cm *ipv6.ControlMessage
+		if ttl > 0 {
+			cm = &ipv6.ControlMessage{HopLimit: ttl}
+		}
+		if _, err := thisPinger.conn.v6.IPv6PacketConn().WriteTo(wb, cm, target.netIPAddr); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	//TTL is a socket option, shared by all sender goroutines, so ttl 0 restores the default
+	thisPinger.conn.v4Lock.Lock()
+	defer thisPinger.conn.v4Lock.Unlock()
+	if ttl <= 0 {
+		ttl = thisPinger.conn.v4DefaultTTL
+	}
+	if ttl != thisPinger.conn.v4TTL {
+		if err := thisPinger.conn.v4.IPv4PacketConn().SetTTL(ttl); err != nil {
+			return err
+		}
+		thisPinger.conn.v4TTL = ttl
+	}
+	if _, err := thisPinger.conn.v4.WriteTo(wb, target.netIPAddr); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (thisPinger *Pinger) setTimeouter(ctx context.Context, target probeTarget, seq int, ttl int, sendTimeNanosec int64) {
+	target.reqList.Lock()
+	defer target.reqList.Unlock()
+
+	req := &target.reqList.req[seq&(responseListNum-1)]
+	if req.timeouter != nil && req.timeouter.Stop() {
+		atomic.AddInt64(&thisPinger.status.timeouterCounter, -1)
+	}
+
+	target.probeCount.Lock()
+	target.probeCount.sent++
+	target.probeCount.Unlock()
+
+	atomic.AddInt64(&thisPinger.status.timeouterCounter, 1)
+	*req = tReq{
+		seq:             seq,
+		ttl:             ttl,
+		state:           reqStateWaiting,
+		sendTimeNanosec: sendTimeNanosec,
+		timeouter: time.AfterFunc(time.Duration(thisPinger.getTimeoutMillisec())*time.Millisecond, func() {
+			defer atomic.AddInt64(&thisPinger.status.timeouterCounter, -1)
+
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			thisPinger.timeout(target, seq)
+		}),
+	}
+}
+
+func (thisPinger *Pinger) timeout(target probeTarget, seq int) {
+	target.reqList.Lock()
+	req := &target.reqList.req[seq&(responseListNum-1)]
+	if req.seq != seq || req.state != reqStateWaiting {
+		target.reqList.Unlock()
+		return
+	}
+	req.state = reqStateTimeout
+	ttl := req.ttl
+	sendTimeNanosec := req.sendTimeNanosec
+	target.reqList.Unlock()
+
+	thisPinger.sendResult(IcmpResult{
+		ResultType:             IcmpResultTypeTimeout,
+		IcmpTargetID:           target.id,
+		Seq:                    seq,
+		TTL:                    ttl,
+		SendTimeUnixNanosec:    sendTimeNanosec,
+		ReceiveTimeUnixNanosec: time.Now().UnixNano(),
+	})
+}
+
+func (thisPinger *Pinger) stopTimeouters() {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	for _, target := range thisPinger.targets {
+		thisPinger.stopTargetTimeouters(target)
+	}
+}
+
+func (thisPinger *Pinger) sendResult(result IcmpResult) {
+	select {
+	case thisPinger.chIcmpResult <- result:
+	default:
+		thisPinger.logger.Log(labelinglog.FlgWarn, "busy statistics")
+		atomic.AddInt64(&thisPinger.status.resultDropCounter, 1)
+		if isFinalResult(result.ResultType) {
+			thisPinger.finishProbe(result.IcmpTargetID, result.ResultType, thisPinger.isHopResult(result))
+		}
+	}
+}
+
+//payload returns probe data of PayloadSize
+//PayloadPattern fills the whole data, otherwise data starts with header and the rest is zero
+func (thisPinger *Pinger) payload(header []byte) []byte {
+	if thisPinger.config.PayloadSize <= 0 {
+		return header
+	}
+
+	res := make([]byte, thisPinger.config.PayloadSize)
+	if pattern := thisPinger.config.PayloadPattern; len(pattern) > 0 {
+		for i := range res {
+			res[i] = pattern[i%len(pattern)]
+		}
+	} else {
+		copy(res, header)
+	}
+
+	return res
+}
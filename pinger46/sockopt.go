@@ -0,0 +1,76 @@
+package pinger46
+
+import (
+	"errors"
+	"syscall"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+func (thisPinger *Pinger) setIcmpOption(conn *icmp.PacketConn, isIPv6 bool) error {
+	if isIPv6 {
+		packetConn := conn.IPv6PacketConn()
+		syscallConn, ok := packetConn.PacketConn.(syscall.Conn)
+		if !ok {
+			return errors.New("unsupported conn")
+		}
+		return thisPinger.setIPv6Option(packetConn, syscallConn)
+	}
+
+	packetConn := conn.IPv4PacketConn()
+	syscallConn, ok := packetConn.PacketConn.(syscall.Conn)
+	if !ok {
+		return errors.New("unsupported conn")
+	}
+	return thisPinger.setIPv4Option(packetConn, syscallConn)
+}
+
+func (thisPinger *Pinger) setIPv4Option(conn *ipv4.PacketConn, syscallConn syscall.Conn) error {
+	if thisPinger.config.TTL > 0 {
+		if err := conn.SetTTL(int(thisPinger.config.TTL)); err != nil {
+			return err
+		}
+	}
+	if thisPinger.config.TOS > 0 {
+		if err := conn.SetTOS(int(thisPinger.config.TOS)); err != nil {
+			return err
+		}
+	}
+	if thisPinger.config.DontFragment {
+		rawConn, err := syscallConn.SyscallConn()
+		if err != nil {
+			return err
+		}
+		if err := setDontFragment(rawConn, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (thisPinger *Pinger) setIPv6Option(conn *ipv6.PacketConn, syscallConn syscall.Conn) error {
+	if thisPinger.config.TTL > 0 {
+		if err := conn.SetHopLimit(int(thisPinger.config.TTL)); err != nil {
+			return err
+		}
+	}
+	if thisPinger.config.TOS > 0 {
+		if err := conn.SetTrafficClass(int(thisPinger.config.TOS)); err != nil {
+			return err
+		}
+	}
+	if thisPinger.config.DontFragment {
+		rawConn, err := syscallConn.SyscallConn()
+		if err != nil {
+			return err
+		}
+		if err := setDontFragment(rawConn, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+//go:build linux
+
+package pinger46
+
+import (
+	"syscall"
+)
+
+func setDontFragment(rawConn syscall.RawConn, isIPv6 bool) error {
+	if isIPv6 {
+		return setsockoptInt(rawConn, syscall.IPPROTO_IPV6, syscall.IPV6_MTU_DISCOVER, syscall.IPV6_PMTUDISC_DO)
+	}
+	return setsockoptInt(rawConn, syscall.IPPROTO_IP, syscall.IP_MTU_DISCOVER, syscall.IP_PMTUDISC_DO)
+}
+
+//setDontFragmentProbe sets DF and ignores the path MTU cached by the kernel
+func setDontFragmentProbe(rawConn syscall.RawConn, isIPv6 bool) error {
+	if isIPv6 {
+		return setsockoptInt(rawConn, syscall.IPPROTO_IPV6, syscall.IPV6_MTU_DISCOVER, syscall.IPV6_PMTUDISC_PROBE)
+	}
+	return setsockoptInt(rawConn, syscall.IPPROTO_IP, syscall.IP_MTU_DISCOVER, syscall.IP_PMTUDISC_PROBE)
+}
+
+//setTCPOption is called before connect, x/net/ipv4 can not be used for TCP dial
+func (thisPinger *Pinger) setTCPOption(rawConn syscall.RawConn, isIPv6 bool) error {
+	if thisPinger.config.TTL > 0 {
+		var err error
+		if isIPv6 {
+			err = setsockoptInt(rawConn, syscall.IPPROTO_IPV6, syscall.IPV6_UNICAST_HOPS, int(thisPinger.config.TTL))
+		} else {
+			err = setsockoptInt(rawConn, syscall.IPPROTO_IP, syscall.IP_TTL, int(thisPinger.config.TTL))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if thisPinger.config.TOS > 0 {
+		var err error
+		if isIPv6 {
+			err = setsockoptInt(rawConn, syscall.IPPROTO_IPV6, syscall.IPV6_TCLASS, int(thisPinger.config.TOS))
+		} else {
+			err = setsockoptInt(rawConn, syscall.IPPROTO_IP, syscall.IP_TOS, int(thisPinger.config.TOS))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if thisPinger.config.DontFragment {
+		if err := setDontFragment(rawConn, isIPv6); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setsockoptInt(rawConn syscall.RawConn, level int, opt int, value int) error {
+	var sockErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		sockErr = syscall.SetsockoptInt(int(fd), level, opt, value)
+	}); err != nil {
+		return err
+	}
+
+	return sockErr
+}
@@ -0,0 +1,24 @@
+//go:build !linux
+
+package pinger46
+
+import (
+	"errors"
+	"syscall"
+)
+
+func setDontFragment(rawConn syscall.RawConn, isIPv6 bool) error {
+	return errors.New("DontFragment is not supported on this platform")
+}
+
+func setDontFragmentProbe(rawConn syscall.RawConn, isIPv6 bool) error {
+	return errors.New("DontFragment is not supported on this platform")
+}
+
+func (thisPinger *Pinger) setTCPOption(rawConn syscall.RawConn, isIPv6 bool) error {
+	if thisPinger.config.TTL > 0 || thisPinger.config.TOS > 0 || thisPinger.config.DontFragment {
+		return errors.New("TTL, TOS and DontFragment of TCP probe are not supported on this platform")
+	}
+
+	return nil
+}
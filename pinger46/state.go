@@ -0,0 +1,159 @@
+package pinger46
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+//TargetState a
+type TargetState uint8
+
+//TargetState a
+const (
+	TargetStateUnknown = TargetState(iota)
+	TargetStateUp
+	TargetStateDegraded
+	TargetStateDown
+)
+
+//TargetStateChange a
+type TargetStateChange struct {
+	IcmpTargetID          TargetID
+	OldState              TargetState
+	NewState              TargetState
+	ChangeTimeUnixNanosec int64
+	//-1 if no reply received yet
+	LastRttNanosec int64
+	LossPercent    float64
+}
+
+type tStateData struct {
+	sync.Mutex
+	state              TargetState
+	consecutiveLoss    int64
+	consecutiveSuccess int64
+	lastChangeNanosec  int64
+	lastRttNanosec     int64
+}
+
+//GetChTargetStateChange a
+func (thisPinger *Pinger) GetChTargetStateChange(cap int) <-chan TargetStateChange {
+	ch := make(chan TargetStateChange, cap)
+
+	thisPinger.chTargetStateSubscriber.Lock()
+	defer thisPinger.chTargetStateSubscriber.Unlock()
+	thisPinger.chTargetStateSubscriber.list = append(thisPinger.chTargetStateSubscriber.list, ch)
+
+	return ch
+}
+
+//ResizeChTargetStateChange replaces ch from GetChTargetStateChange with a larger one, nothing is sent to ch after the return
+func (thisPinger *Pinger) ResizeChTargetStateChange(ch <-chan TargetStateChange, newCap int) <-chan TargetStateChange {
+	thisPinger.chTargetStateSubscriber.Lock()
+	defer thisPinger.chTargetStateSubscriber.Unlock()
+	for i, c := range thisPinger.chTargetStateSubscriber.list {
+		if c != ch {
+			continue
+		}
+		if cap(c) >= newCap {
+			return ch
+		}
+		newCh := make(chan TargetStateChange, newCap)
+		thisPinger.chTargetStateSubscriber.list[i] = newCh
+		return newCh
+	}
+
+	return ch
+}
+
+//GetTargetStates a
+func (thisPinger *Pinger) GetTargetStates() map[TargetID]TargetState {
+	res := make(map[TargetID]TargetState)
+
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+	for targetID, stateData := range thisPinger.stateData.targets {
+		stateData.Lock()
+		res[targetID] = stateData.state
+		stateData.Unlock()
+	}
+
+	return res
+}
+
+func (thisPinger *Pinger) updateState(result IcmpResult, isSuccess bool) {
+	stateData, ok := thisPinger.getStateData(result.IcmpTargetID)
+	if !ok {
+		return
+	}
+	lossPercent := thisPinger.lossPercent(result.IcmpTargetID)
+
+	stateData.Lock()
+	defer stateData.Unlock()
+
+	if isSuccess {
+		stateData.consecutiveSuccess++
+		stateData.consecutiveLoss = 0
+		stateData.lastRttNanosec = result.ReceiveTimeUnixNanosec - result.SendTimeUnixNanosec
+	} else {
+		stateData.consecutiveLoss++
+		stateData.consecutiveSuccess = 0
+	}
+
+	newState := stateData.state
+	if stateData.consecutiveLoss >= thisPinger.config.DownLossCount {
+		newState = TargetStateDown
+	} else if stateData.consecutiveSuccess >= thisPinger.config.UpSuccessCount || (stateData.state != TargetStateDown && stateData.state != TargetStateUnknown) {
+		newState = TargetStateUp
+		if thisPinger.config.DegradedLossPercent > 0 && lossPercent >= thisPinger.config.DegradedLossPercent {
+			newState = TargetStateDegraded
+		}
+	}
+	if newState == stateData.state {
+		return
+	}
+
+	//flap damping
+	nowNanosec := time.Now().UnixNano()
+	if stateData.state != TargetStateUnknown && nowNanosec-stateData.lastChangeNanosec < thisPinger.config.HoldDownMillisec*1000*1000 {
+		return
+	}
+
+	thisPinger.sendTargetStateChange(TargetStateChange{
+		IcmpTargetID:          result.IcmpTargetID,
+		OldState:              stateData.state,
+		NewState:              newState,
+		ChangeTimeUnixNanosec: nowNanosec,
+		LastRttNanosec:        stateData.lastRttNanosec,
+		LossPercent:           lossPercent,
+	})
+	stateData.state = newState
+	stateData.lastChangeNanosec = nowNanosec
+}
+
+func (thisPinger *Pinger) lossPercent(targetID TargetID) float64 {
+	target, ok := thisPinger.getStatisticsData(targetID)
+	if !ok {
+		return 0
+	}
+	target.Lock()
+	defer target.Unlock()
+
+	return target.lossPercent()
+}
+
+func (thisPinger *Pinger) sendTargetStateChange(targetStateChange TargetStateChange) {
+	thisPinger.chTargetStateSubscriber.Lock()
+	defer thisPinger.chTargetStateSubscriber.Unlock()
+	for _, ch := range thisPinger.chTargetStateSubscriber.list {
+		select {
+		case ch <- targetStateChange:
+		default:
+			thisPinger.logger.Log(labelinglog.FlgWarn, "busy target state subscriber skip")
+			atomic.AddInt64(&thisPinger.status.targetStateSubscriberDropCounter, 1)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package pinger46
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUpdateState(t *testing.T) {
+	tests := []struct {
+		name                string
+		downLossCount       int64
+		upSuccessCount      int64
+		degradedLossPercent float64
+		holdDownMillisec    int64
+		results             []bool
+		want                []TargetState
+	}{
+		{
+			name:           "up after enough successes",
+			downLossCount:  2,
+			upSuccessCount: 2,
+			results:        []bool{true, true, true},
+			want:           []TargetState{TargetStateUp},
+		},
+		{
+			name:           "down after enough losses",
+			downLossCount:  2,
+			upSuccessCount: 2,
+			results:        []bool{false, false, false},
+			want:           []TargetState{TargetStateDown},
+		},
+		{
+			name:           "a single loss does not take it down",
+			downLossCount:  2,
+			upSuccessCount: 2,
+			results:        []bool{true, true, false, true, false, true},
+			want:           []TargetState{TargetStateUp},
+		},
+		{
+			name:           "down and up again",
+			downLossCount:  2,
+			upSuccessCount: 2,
+			results:        []bool{true, true, false, false, true, true},
+			want:           []TargetState{TargetStateUp, TargetStateDown, TargetStateUp},
+		},
+		{
+			name:                "degraded by loss percent over real samples",
+			downLossCount:       2,
+			upSuccessCount:      2,
+			degradedLossPercent: 30,
+			results:             []bool{true, true, false, true},
+			want:                []TargetState{TargetStateUp, TargetStateDegraded, TargetStateUp},
+		},
+		{
+			name:             "hold down suppresses flapping",
+			downLossCount:    2,
+			upSuccessCount:   2,
+			holdDownMillisec: 60 * 1000,
+			results:          []bool{true, true, false, false, true, true},
+			want:             []TargetState{TargetStateUp},
+		},
+		{
+			name:             "hold down does not delay the first change",
+			downLossCount:    2,
+			upSuccessCount:   2,
+			holdDownMillisec: 60 * 1000,
+			results:          []bool{false, false},
+			want:             []TargetState{TargetStateDown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.StatisticsCountsNum = 4
+			config.DownLossCount = tt.downLossCount
+			config.UpSuccessCount = tt.upSuccessCount
+			config.DegradedLossPercent = tt.degradedLossPercent
+			config.HoldDownMillisec = tt.holdDownMillisec
+			pinger := New(1, config)
+			if err := pinger.AddTarget("127.0.0.1", ""); err != nil {
+				t.Fatal(err)
+			}
+			targetID := pinger.GetTargetsOrder()[0]
+			ch := pinger.GetChTargetStateChange(len(tt.results))
+
+			for _, isSuccess := range tt.results {
+				nowNanosec := time.Now().UnixNano()
+				result := IcmpResult{
+					IcmpTargetID:        targetID,
+					SendTimeUnixNanosec: nowNanosec,
+				}
+				if isSuccess {
+					result.ReceiveTimeUnixNanosec = nowNanosec + 1000
+					pinger.addResult(targetID, 1, nowNanosec, 1000)
+				} else {
+					pinger.addResult(targetID, 0, nowNanosec, -1)
+				}
+				pinger.updateState(result, isSuccess)
+			}
+
+			got := make([]TargetState, 0)
+			oldState := TargetStateUnknown
+			for len(ch) > 0 {
+				change := <-ch
+				if change.OldState != oldState {
+					t.Errorf("OldState = %v, want %v", change.OldState, oldState)
+				}
+				oldState = change.NewState
+				got = append(got, change.NewState)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("states = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package pinger46
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+func (thisPinger *Pinger) statistics(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer thisPinger.logger.Log(labelinglog.FlgDebug, "finish")
+
+	defer thisPinger.cancelFunc()
+
+	for {
+		select {
+		case <-ctx.Done():
+			thisPinger.logger.Log(labelinglog.FlgDebug, "stop request received")
+			return
+		case result := <-thisPinger.chIcmpResult:
+			if _, ok := thisPinger.getTarget(result.IcmpTargetID); !ok {
+				//removed target
+				continue
+			}
+
+			if result.TTL > 0 {
+				thisPinger.addTraceResult(result)
+			}
+			//hops short of the target only go to the trace
+			isHop := thisPinger.isHopResult(result)
+
+			switch {
+			case isHop:
+			case result.ResultType == IcmpResultTypeReceive:
+				thisPinger.addResult(result.IcmpTargetID, 1, result.SendTimeUnixNanosec, result.ReceiveTimeUnixNanosec-result.SendTimeUnixNanosec)
+				thisPinger.updateState(result, true)
+			case result.ResultType == IcmpResultTypeReceiveAfterTimeout:
+			case result.ResultType == IcmpResultTypeRedirect:
+			case result.ResultType == IcmpResultTypeTTLExceeded:
+				thisPinger.addResult(result.IcmpTargetID, 0, result.SendTimeUnixNanosec, -1)
+				thisPinger.updateState(result, false)
+			case result.ResultType == IcmpResultTypeTimeout:
+				thisPinger.addResult(result.IcmpTargetID, 0, result.SendTimeUnixNanosec, -1)
+				thisPinger.updateState(result, false)
+			default:
+				thisPinger.addResult(result.IcmpTargetID, 0, result.SendTimeUnixNanosec, -1)
+				thisPinger.updateState(result, false)
+			}
+
+			(func() {
+				thisPinger.chIcmpResultsSubscriber.Lock()
+				defer thisPinger.chIcmpResultsSubscriber.Unlock()
+				for _, ch := range thisPinger.chIcmpResultsSubscriber.list {
+					select {
+					case ch <- result:
+					default:
+						thisPinger.logger.Log(labelinglog.FlgWarn, "busy results subscriber skip")
+						atomic.AddInt64(&thisPinger.status.resultSubscriberDropCounter, 1)
+					}
+				}
+			})()
+
+			//after the result is passed to the subscribers
+			if isFinalResult(result.ResultType) {
+				thisPinger.finishProbe(result.IcmpTargetID, result.ResultType, isHop)
+			}
+		}
+	}
+}
+
+func (thisPinger *Pinger) addResult(targetID TargetID, res int64, sendTimeNanosec int64, rttNanosec int64) {
+	target, ok := thisPinger.getStatisticsData(targetID)
+	if !ok {
+		return
+	}
+	target.Lock()
+	defer target.Unlock()
+
+	target.Res[target.Index] = res
+	target.Rtt[target.Index] = rttNanosec
+	target.Index++
+	if target.Index >= thisPinger.config.StatisticsCountsNum {
+		target.Index = 0
+	}
+	if target.filledNum < int64(len(target.Res)) {
+		target.filledNum++
+	}
+
+	if rttNanosec >= 0 {
+		if target.hasReceivedBefore {
+			d := float64(rttNanosec - target.lastRttNanosec)
+			if d < 0 {
+				d = -d
+			}
+			target.jitterNanosec += (d - target.jitterNanosec) / 16
+		}
+		target.lastRttNanosec = rttNanosec
+		target.hasReceivedBefore = true
+	}
+
+	if thisPinger.statisticsData.maxWindowNanosec > 0 {
+		target.samples = append(target.samples, tSample{
+			sendTimeNanosec: sendTimeNanosec,
+			rttNanosec:      rttNanosec,
+		})
+
+		border := time.Now().UnixNano() - thisPinger.statisticsData.maxWindowNanosec
+		i := 0
+		for i < len(target.samples) && target.samples[i].sendTimeNanosec < border {
+			i++
+		}
+		if i > 0 {
+			target.samples = append(target.samples[:0], target.samples[i:]...)
+		}
+	}
+}
+
+func maxWindowSec(windowsSec []int64) int64 {
+	res := int64(0)
+	for _, windowSec := range windowsSec {
+		if windowSec > res {
+			res = windowSec
+		}
+	}
+	return res
+}
+
+//lossPercent over the filled Res slots only, must be called with the lock held
+func (target *sData) lossPercent() float64 {
+	if target.filledNum <= 0 {
+		return 0
+	}
+
+	lossCount := int64(0)
+	for i := int64(1); i <= target.filledNum; i++ {
+		index := (target.Index - i + int64(len(target.Res))) % int64(len(target.Res))
+		if target.Res[index] <= 0 {
+			lossCount++
+		}
+	}
+
+	return float64(lossCount) * 100 / float64(target.filledNum)
+}
@@ -0,0 +1,241 @@
+package pinger46
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+
+	"github.com/umenosuke/labelinglog"
+	"golang.org/x/net/icmp"
+)
+
+//RemoveTarget a
+func (thisPinger *Pinger) RemoveTarget(ipAddress string) error {
+	thisPinger.isStarted.Lock()
+	defer thisPinger.isStarted.Unlock()
+
+	targetID, _, err := resolveTarget(ipAddress)
+	if err != nil {
+		thisPinger.logger.Log(labelinglog.FlgWarn, err.Error())
+		return err
+	}
+
+	thisPinger.targetsLock.Lock()
+	defer thisPinger.targetsLock.Unlock()
+
+	target, ok := thisPinger.targets[targetID]
+	if !ok {
+		msg := "remove skip not found : " + ipAddress
+		thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+		return errors.New(msg)
+	}
+
+	if target.cancelFunc != nil {
+		target.cancelFunc()
+	}
+	thisPinger.stopTargetTimeouters(target)
+	if conn, ok := thisPinger.conn.udp[targetID]; ok {
+		conn.Close()
+		delete(thisPinger.conn.udp, targetID)
+	}
+	thisPinger.unregisterTarget(targetID)
+	thisPinger.checkCompletedWithoutLock()
+
+	return nil
+}
+
+//GetTargetsOrder a
+func (thisPinger *Pinger) GetTargetsOrder() []TargetID {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	return append(make([]TargetID, 0, len(thisPinger.targetsOrder)), thisPinger.targetsOrder...)
+}
+
+//registerTarget must be called with targetsLock held
+func (thisPinger *Pinger) registerTarget(target probeTarget) {
+	targetID := target.id
+	target.probeCount = &tProbeCount{}
+	thisPinger.targets[targetID] = target
+	thisPinger.targetsOrder = append(thisPinger.targetsOrder, targetID)
+
+	list := make([]int64, thisPinger.config.StatisticsCountsNum)
+	rttList := make([]int64, thisPinger.config.StatisticsCountsNum)
+	for i := range list {
+		list[i] = 1
+		rttList[i] = -1
+	}
+	thisPinger.statisticsData.targets[targetID] = &sData{
+		Res:   list,
+		Index: 0,
+		Rtt:   rttList,
+	}
+
+	thisPinger.stateData.targets[targetID] = &tStateData{
+		state:          TargetStateUnknown,
+		lastRttNanosec: -1,
+	}
+
+	if thisPinger.isTraceTarget(targetID) {
+		thisPinger.traceData.targets[targetID] = &tTraceData{
+			hops:       make([]tHop, thisPinger.config.MaxHops),
+			reachedTTL: thisPinger.config.MaxHops,
+		}
+	}
+}
+
+//unregisterTarget must be called with targetsLock held
+func (thisPinger *Pinger) unregisterTarget(targetID TargetID) {
+	delete(thisPinger.targets, targetID)
+	for i, id := range thisPinger.targetsOrder {
+		if id == targetID {
+			thisPinger.targetsOrder = append(thisPinger.targetsOrder[:i], thisPinger.targetsOrder[i+1:]...)
+			break
+		}
+	}
+	delete(thisPinger.statisticsData.targets, targetID)
+	delete(thisPinger.stateData.targets, targetID)
+	delete(thisPinger.traceData.targets, targetID)
+}
+
+//startTarget opens the sockets the target needs and starts its sender
+//must be called with targetsLock held
+func (thisPinger *Pinger) startTarget(targetID TargetID) error {
+	ctx := thisPinger.running.ctx
+	if ctx == nil || ctx.Err() != nil {
+		return errors.New("Pinger is not running")
+	}
+	wg := thisPinger.running.wg
+	target := thisPinger.targets[targetID]
+
+	switch targetID.ProbeType {
+	case ProbeTypeICMP:
+		if err := thisPinger.openIcmpConn(ctx, target.isIPv6); err != nil {
+			return err
+		}
+	case ProbeTypeUDP:
+		//port unreachable comes to the ICMP socket
+		if err := thisPinger.openIcmpConn(ctx, target.isIPv6); err != nil {
+			return err
+		}
+		if err := thisPinger.openUDPConn(target); err != nil {
+			return err
+		}
+	}
+
+	targetCtx, targetCtxCancel := context.WithCancel(ctx)
+	target.cancelFunc = targetCtxCancel
+	thisPinger.targets[targetID] = target
+
+	if targetID.ProbeType == ProbeTypeUDP {
+		wg.Add(1)
+		go thisPinger.udpListener(targetCtx, wg, target)
+	}
+
+	wg.Add(1)
+	go thisPinger.sendInterval(targetCtx, wg, target)
+
+	return nil
+}
+
+//openIcmpConn opens the ICMP socket and starts its listener, if not yet
+func (thisPinger *Pinger) openIcmpConn(ctx context.Context, isIPv6 bool) error {
+	if isIPv6 {
+		if thisPinger.conn.v6 != nil {
+			return nil
+		}
+		conn, err := icmp.ListenPacket("ip6:ipv6-icmp", thisPinger.config.SourceIPv6Address)
+		if err != nil {
+			return err
+		}
+		setICMPv6Filter(conn)
+		if err := thisPinger.setIcmpOption(conn, true); err != nil {
+			conn.Close()
+			return err
+		}
+		thisPinger.conn.v6 = conn
+
+		thisPinger.logger.Log(labelinglog.FlgDebug, "start listener v6")
+		thisPinger.running.wg.Add(1)
+		go thisPinger.listener(ctx, thisPinger.running.wg, conn, true)
+		return nil
+	}
+
+	if thisPinger.conn.v4 != nil {
+		return nil
+	}
+	conn, err := icmp.ListenPacket("ip4:icmp", thisPinger.config.SourceIPAddress)
+	if err != nil {
+		return err
+	}
+	if err := thisPinger.setIcmpOption(conn, false); err != nil {
+		conn.Close()
+		return err
+	}
+	ttl, err := conn.IPv4PacketConn().TTL()
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	thisPinger.conn.v4 = conn
+	thisPinger.conn.v4DefaultTTL = ttl
+	thisPinger.conn.v4TTL = ttl
+
+	thisPinger.logger.Log(labelinglog.FlgDebug, "start listener v4")
+	thisPinger.running.wg.Add(1)
+	go thisPinger.listener(ctx, thisPinger.running.wg, conn, false)
+	return nil
+}
+
+func (thisPinger *Pinger) stopTargetTimeouters(target probeTarget) {
+	target.reqList.Lock()
+	defer target.reqList.Unlock()
+
+	for i := range target.reqList.req {
+		req := &target.reqList.req[i]
+		if req.timeouter != nil && req.timeouter.Stop() {
+			atomic.AddInt64(&thisPinger.status.timeouterCounter, -1)
+		}
+	}
+}
+
+func (thisPinger *Pinger) getTarget(targetID TargetID) (probeTarget, bool) {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	target, ok := thisPinger.targets[targetID]
+	return target, ok
+}
+
+func (thisPinger *Pinger) getStatisticsData(targetID TargetID) (*sData, bool) {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	data, ok := thisPinger.statisticsData.targets[targetID]
+	return data, ok
+}
+
+func (thisPinger *Pinger) getTraceData(targetID TargetID) (*tTraceData, bool) {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	data, ok := thisPinger.traceData.targets[targetID]
+	return data, ok
+}
+
+func (thisPinger *Pinger) getStateData(targetID TargetID) (*tStateData, bool) {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	data, ok := thisPinger.stateData.targets[targetID]
+	return data, ok
+}
+
+func (thisPinger *Pinger) getUDPConn(targetID TargetID) (*net.UDPConn, bool) {
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+
+	conn, ok := thisPinger.conn.udp[targetID]
+	return conn, ok
+}
@@ -0,0 +1,63 @@
+package pinger46
+
+import (
+	"context"
+	"errors"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+func (thisPinger *Pinger) sendTCP(ctx context.Context, target probeTarget, seq int) error {
+	nowNanosec := time.Now().UnixNano()
+
+	sourceIPAddress := thisPinger.config.SourceIPAddress
+	if target.isIPv6 {
+		sourceIPAddress = thisPinger.config.SourceIPv6Address
+	}
+	dialer := net.Dialer{
+		Timeout:   time.Duration(thisPinger.getTimeoutMillisec()) * time.Millisecond,
+		LocalAddr: &net.TCPAddr{IP: net.ParseIP(sourceIPAddress)},
+		Control: func(network, address string, rawConn syscall.RawConn) error {
+			return thisPinger.setTCPOption(rawConn, target.isIPv6)
+		},
+	}
+
+	thisPinger.setTimeouter(ctx, target, seq, 0, nowNanosec)
+
+	go (func() {
+		conn, err := dialer.DialContext(ctx, "tcp", target.hostPort)
+		receiveTimeNanosec := time.Now().UnixNano()
+
+		res := icmpResponse{
+			targetID:           target.id,
+			seq:                seq,
+			receiveTimeNanosec: receiveTimeNanosec,
+		}
+		if err == nil {
+			conn.Close()
+			res.resultType = IcmpResultTypeReceive
+		} else if errors.Is(err, syscall.ECONNREFUSED) {
+			//RST from the target
+			res.peer = target.netIPAddr
+			res.resultType = IcmpResultTypeRefused
+		} else if errors.Is(err, syscall.EHOSTUNREACH) {
+			res.resultType = IcmpResultTypeHostUnreachable
+		} else if errors.Is(err, syscall.ENETUNREACH) {
+			res.resultType = IcmpResultTypeNetUnreachable
+		} else {
+			// timeout is reported by timeouter
+			thisPinger.logger.Log(labelinglog.FlgDebug, "("+target.ipAddress+") "+err.Error())
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+		case thisPinger.chIcmpResponse <- res:
+		}
+	})()
+
+	return nil
+}
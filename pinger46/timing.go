@@ -0,0 +1,166 @@
+package pinger46
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+//SetIntervalTimeout changes interval and timeout of a running pinger, 0 is unchanged
+//when the interval changes, each target sends the next probe immediately and then follows the new interval
+func (thisPinger *Pinger) SetIntervalTimeout(intervalMillisec int64, timeoutMillisec int64) error {
+	if intervalMillisec < 0 || timeoutMillisec < 0 {
+		msg := "invalid interval or timeout"
+		thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+		return errors.New(msg)
+	}
+
+	thisPinger.isStarted.Lock()
+	defer thisPinger.isStarted.Unlock()
+
+	oldIntervalMillisec := thisPinger.getIntervalMillisec()
+	if intervalMillisec == 0 {
+		intervalMillisec = oldIntervalMillisec
+	}
+	if timeoutMillisec == 0 {
+		timeoutMillisec = thisPinger.getTimeoutMillisec()
+	}
+	timeoutMillisec = thisPinger.limitTimeout(intervalMillisec, timeoutMillisec)
+
+	atomic.StoreInt64(&thisPinger.config.IntervalMillisec, intervalMillisec)
+	atomic.StoreInt64(&thisPinger.config.TimeoutMillisec, timeoutMillisec)
+
+	if intervalMillisec != oldIntervalMillisec {
+		thisPinger.wakeupSenders()
+	}
+
+	return nil
+}
+
+//Pause stops sending probes, targets and statistics are kept
+func (thisPinger *Pinger) Pause() error {
+	thisPinger.pause.Lock()
+	defer thisPinger.pause.Unlock()
+
+	if thisPinger.pause.isPaused {
+		msg := "Pinger has already paused"
+		thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+		return errors.New(msg)
+	}
+	thisPinger.pause.isPaused = true
+	thisPinger.pause.pausedNanosec = time.Now().UnixNano()
+
+	return nil
+}
+
+//Resume a
+func (thisPinger *Pinger) Resume() error {
+	thisPinger.pause.Lock()
+	defer thisPinger.pause.Unlock()
+
+	if !thisPinger.pause.isPaused {
+		msg := "Pinger is not paused"
+		thisPinger.logger.Log(labelinglog.FlgWarn, msg)
+		return errors.New(msg)
+	}
+	thisPinger.pause.isPaused = false
+	thisPinger.pause.totalPausedNanosec += time.Now().UnixNano() - thisPinger.pause.pausedNanosec
+	thisPinger.pause.pausedNanosec = 0
+
+	thisPinger.wakeupSenders()
+
+	return nil
+}
+
+//PauseStatus a
+type PauseStatus struct {
+	IsPaused bool
+	//0 if not paused
+	PausedUnixNanosec int64
+	//including the current pause
+	TotalPausedNanosec int64
+}
+
+//GetPauseStatus a
+func (thisPinger *Pinger) GetPauseStatus() PauseStatus {
+	thisPinger.pause.Lock()
+	defer thisPinger.pause.Unlock()
+
+	res := PauseStatus{
+		IsPaused:           thisPinger.pause.isPaused,
+		PausedUnixNanosec:  thisPinger.pause.pausedNanosec,
+		TotalPausedNanosec: thisPinger.pause.totalPausedNanosec,
+	}
+	if res.IsPaused {
+		res.TotalPausedNanosec += time.Now().UnixNano() - res.PausedUnixNanosec
+	}
+
+	return res
+}
+
+func (thisPinger *Pinger) isPaused() bool {
+	thisPinger.pause.Lock()
+	defer thisPinger.pause.Unlock()
+
+	return thisPinger.pause.isPaused
+}
+
+func (thisPinger *Pinger) wakeupSenders() {
+	thisPinger.senderWakeup.Lock()
+	defer thisPinger.senderWakeup.Unlock()
+
+	close(thisPinger.senderWakeup.ch)
+	thisPinger.senderWakeup.ch = make(chan struct{})
+}
+
+func (thisPinger *Pinger) getChSenderWakeup() <-chan struct{} {
+	thisPinger.senderWakeup.Lock()
+	defer thisPinger.senderWakeup.Unlock()
+
+	return thisPinger.senderWakeup.ch
+}
+
+//addInterval doubles the interval when the pinger is too busy, at most once in 5 seconds
+func (thisPinger *Pinger) addInterval() {
+	now := time.Now().UnixNano()
+	thisPinger.addIntervalVar.Lock()
+	defer thisPinger.addIntervalVar.Unlock()
+	if thisPinger.addIntervalVar.lastExecutionTime+(5*1000*1000*1000) < now {
+		thisPinger.addIntervalVar.lastExecutionTime = now
+		oldInterval := thisPinger.getIntervalMillisec()
+		atomic.StoreInt64(&thisPinger.config.IntervalMillisec, oldInterval*2)
+		thisPinger.logger.Log(labelinglog.FlgWarn, "pinger busy, interval change ["+strconv.FormatInt(oldInterval, 10)+"ms to "+strconv.FormatInt(oldInterval*2, 10)+"ms]")
+	}
+}
+
+//MaxTimeoutMillisec returns the longest timeout for seq not to wrap around the request list
+func MaxTimeoutMillisec(intervalMillisec int64, mode Mode, maxHops int64) int64 {
+	timeoutLimit := intervalMillisec * responseListNum / 2
+	if mode == ModeTrace && maxHops > 0 {
+		timeoutLimit /= maxHops
+	}
+
+	return timeoutLimit
+}
+
+//limitTimeout returns timeout short enough for seq not to wrap around the request list
+func (thisPinger *Pinger) limitTimeout(intervalMillisec int64, timeoutMillisec int64) int64 {
+	timeoutLimit := MaxTimeoutMillisec(intervalMillisec, thisPinger.config.Mode, thisPinger.config.MaxHops)
+	if timeoutLimit < timeoutMillisec {
+		thisPinger.logger.Log(labelinglog.FlgWarn, "timeout too long, change timeout ["+strconv.FormatInt(timeoutMillisec, 10)+" to "+strconv.FormatInt(timeoutLimit, 10)+"]")
+		return timeoutLimit
+	}
+
+	return timeoutMillisec
+}
+
+func (thisPinger *Pinger) getIntervalMillisec() int64 {
+	return atomic.LoadInt64(&thisPinger.config.IntervalMillisec)
+}
+
+func (thisPinger *Pinger) getTimeoutMillisec() int64 {
+	return atomic.LoadInt64(&thisPinger.config.TimeoutMillisec)
+}
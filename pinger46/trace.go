@@ -0,0 +1,208 @@
+package pinger46
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+//Hop a
+type Hop struct {
+	TTL            int
+	BinPeerIP      BinIPAddress
+	SentCount      int64
+	ReceivedCount  int64
+	LastResultType IcmpResultType
+	LastRttNanosec int64
+	MinRttNanosec  int64
+	AvgRttNanosec  int64
+	MaxRttNanosec  int64
+}
+
+//HopTable a
+type HopTable map[TargetID][]Hop
+
+//PathChange a
+type PathChange struct {
+	IcmpTargetID          TargetID
+	TTL                   int
+	OldBinPeerIP          BinIPAddress
+	NewBinPeerIP          BinIPAddress
+	ChangeTimeUnixNanosec int64
+}
+
+type tHop struct {
+	peer            BinIPAddress
+	sentCount       int64
+	receivedCount   int64
+	lastResultType  IcmpResultType
+	lastRttNanosec  int64
+	minRttNanosec   int64
+	maxRttNanosec   int64
+	totalRttNanosec int64
+	hasReceivedOnce bool
+}
+
+type tTraceData struct {
+	sync.Mutex
+	hops []tHop
+
+	//probes are sent with TTL 1 to reachedTTL
+	reachedTTL int64
+}
+
+func (thisTraceData *tTraceData) getReachedTTL() int {
+	return int(atomic.LoadInt64(&thisTraceData.reachedTTL))
+}
+
+func (thisPinger *Pinger) isTraceTarget(targetID TargetID) bool {
+	//TCP can not set TTL per probe without a raw socket
+	return thisPinger.config.Mode == ModeTrace && targetID.ProbeType != ProbeTypeTCP
+}
+
+//GetChPathChange a
+func (thisPinger *Pinger) GetChPathChange(cap int) <-chan PathChange {
+	ch := make(chan PathChange, cap)
+
+	thisPinger.chPathChangeSubscriber.Lock()
+	defer thisPinger.chPathChangeSubscriber.Unlock()
+	thisPinger.chPathChangeSubscriber.list = append(thisPinger.chPathChangeSubscriber.list, ch)
+
+	return ch
+}
+
+//ResizeChPathChange replaces ch from GetChPathChange with a larger one, nothing is sent to ch after the return
+func (thisPinger *Pinger) ResizeChPathChange(ch <-chan PathChange, newCap int) <-chan PathChange {
+	thisPinger.chPathChangeSubscriber.Lock()
+	defer thisPinger.chPathChangeSubscriber.Unlock()
+	for i, c := range thisPinger.chPathChangeSubscriber.list {
+		if c != ch {
+			continue
+		}
+		if cap(c) >= newCap {
+			return ch
+		}
+		newCh := make(chan PathChange, newCap)
+		thisPinger.chPathChangeSubscriber.list[i] = newCh
+		return newCh
+	}
+
+	return ch
+}
+
+//GetHopTable a
+func (thisPinger *Pinger) GetHopTable() HopTable {
+	res := make(HopTable)
+
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+	for targetID, traceData := range thisPinger.traceData.targets {
+		(func() {
+			traceData.Lock()
+			defer traceData.Unlock()
+
+			hopsNum := traceData.getReachedTTL()
+			hops := make([]Hop, 0, hopsNum)
+			for i := 0; i < hopsNum; i++ {
+				hop := &traceData.hops[i]
+				avgRttNanosec := int64(0)
+				if hop.receivedCount > 0 {
+					avgRttNanosec = hop.totalRttNanosec / hop.receivedCount
+				}
+				hops = append(hops, Hop{
+					TTL:            i + 1,
+					BinPeerIP:      hop.peer,
+					SentCount:      hop.sentCount,
+					ReceivedCount:  hop.receivedCount,
+					LastResultType: hop.lastResultType,
+					LastRttNanosec: hop.lastRttNanosec,
+					MinRttNanosec:  hop.minRttNanosec,
+					AvgRttNanosec:  avgRttNanosec,
+					MaxRttNanosec:  hop.maxRttNanosec,
+				})
+			}
+			res[targetID] = hops
+		})()
+	}
+
+	return res
+}
+
+//isHopResult reports whether the result is for a hop short of the target, not the target's own result
+func (thisPinger *Pinger) isHopResult(result IcmpResult) bool {
+	traceData, ok := thisPinger.getTraceData(result.IcmpTargetID)
+	return ok && result.TTL < traceData.getReachedTTL()
+}
+
+func (thisPinger *Pinger) addTraceResult(result IcmpResult) {
+	traceData, ok := thisPinger.getTraceData(result.IcmpTargetID)
+	if !ok || result.TTL <= 0 || result.TTL > len(traceData.hops) {
+		return
+	}
+
+	switch result.ResultType {
+	case IcmpResultTypeReceiveAfterTimeout, IcmpResultTypeRedirect:
+		return
+	}
+
+	traceData.Lock()
+	defer traceData.Unlock()
+
+	hop := &traceData.hops[result.TTL-1]
+	hop.sentCount++
+	hop.lastResultType = result.ResultType
+	if result.ResultType == IcmpResultTypeTimeout {
+		return
+	}
+
+	rtt := result.ReceiveTimeUnixNanosec - result.SendTimeUnixNanosec
+	hop.receivedCount++
+	hop.lastRttNanosec = rtt
+	hop.totalRttNanosec += rtt
+	if !hop.hasReceivedOnce || rtt < hop.minRttNanosec {
+		hop.minRttNanosec = rtt
+	}
+	if !hop.hasReceivedOnce || rtt > hop.maxRttNanosec {
+		hop.maxRttNanosec = rtt
+	}
+	hop.hasReceivedOnce = true
+
+	peer := result.BinPeerIP
+	if result.ResultType == IcmpResultTypeReceive {
+		peer = result.IcmpTargetID.BinIP
+	}
+	if hop.peer != (BinIPAddress{}) && hop.peer != peer {
+		thisPinger.sendPathChange(PathChange{
+			IcmpTargetID:          result.IcmpTargetID,
+			TTL:                   result.TTL,
+			OldBinPeerIP:          hop.peer,
+			NewBinPeerIP:          peer,
+			ChangeTimeUnixNanosec: result.ReceiveTimeUnixNanosec,
+		})
+	}
+	hop.peer = peer
+
+	reachedTTL := traceData.getReachedTTL()
+	if peer == result.IcmpTargetID.BinIP {
+		if result.TTL < reachedTTL {
+			atomic.StoreInt64(&traceData.reachedTTL, int64(result.TTL))
+		}
+	} else if result.TTL == reachedTTL {
+		//path got longer
+		atomic.StoreInt64(&traceData.reachedTTL, int64(len(traceData.hops)))
+	}
+}
+
+func (thisPinger *Pinger) sendPathChange(pathChange PathChange) {
+	thisPinger.chPathChangeSubscriber.Lock()
+	defer thisPinger.chPathChangeSubscriber.Unlock()
+	for _, ch := range thisPinger.chPathChangeSubscriber.list {
+		select {
+		case ch <- pathChange:
+		default:
+			thisPinger.logger.Log(labelinglog.FlgWarn, "busy path change subscriber skip")
+			atomic.AddInt64(&thisPinger.status.pathChangeSubscriberDropCounter, 1)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package pinger46
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+//BinIPAddress a
+//IPv4 is stored as IPv4-mapped IPv6 address (::ffff:a.b.c.d)
+type BinIPAddress [16]byte
+
+//NetIP2BinIPAddress a
+func NetIP2BinIPAddress(ip net.IP) BinIPAddress {
+	var res BinIPAddress
+	copy(res[:], ip.To16())
+	return res
+}
+
+//BinIPAddress2String a
+func BinIPAddress2String(ipBin BinIPAddress) string {
+	return net.IP(ipBin[:]).String()
+}
+
+//BinIPAddress2BinIPv4 a
+//return 0 if not IPv4
+func BinIPAddress2BinIPv4(ipBin BinIPAddress) uint32 {
+	if !ipBin.IsIPv4() {
+		return 0
+	}
+	return binary.BigEndian.Uint32(ipBin[12:16])
+}
+
+//IsIPv4 a
+func (ipBin BinIPAddress) IsIPv4() bool {
+	return net.IP(ipBin[:]).To4() != nil
+}
+
+//BinIPAddress2Bytes a
+func BinIPAddress2Bytes(ipBin BinIPAddress) []byte {
+	return append(make([]byte, 0, len(ipBin)), ipBin[:]...)
+}
@@ -0,0 +1,145 @@
+package pinger46
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/umenosuke/labelinglog"
+)
+
+func (thisPinger *Pinger) openUDPConn(target probeTarget) error {
+	sourceIPAddress := thisPinger.config.SourceIPAddress
+	network := "udp4"
+	if target.isIPv6 {
+		sourceIPAddress = thisPinger.config.SourceIPv6Address
+		network = "udp6"
+	}
+
+	conn, err := net.ListenUDP(network, &net.UDPAddr{IP: net.ParseIP(sourceIPAddress)})
+	if err != nil {
+		return err
+	}
+
+	if target.isIPv6 {
+		err = thisPinger.setIPv6Option(ipv6.NewPacketConn(conn), conn)
+	} else {
+		err = thisPinger.setIPv4Option(ipv4.NewPacketConn(conn), conn)
+	}
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	thisPinger.conn.udp[target.id] = conn
+
+	return nil
+}
+
+func (thisPinger *Pinger) sendUDP(ctx context.Context, target probeTarget, seq int, ttl int) error {
+	nowNanosec := time.Now().UnixNano()
+
+	wb := bytes.NewBuffer(make([]byte, 0, 12))
+	binary.Write(wb, binary.LittleEndian, &tUDPData{
+		ID:              uint16(thisPinger.icmpID),
+		Seq:             uint16(seq),
+		SendTimeNanosec: nowNanosec,
+	})
+
+	thisPinger.setTimeouter(ctx, target, seq, ttl, nowNanosec)
+
+	conn, ok := thisPinger.getUDPConn(target.id)
+	if !ok {
+		return errors.New("udp conn not found")
+	}
+	udpAddr := &net.UDPAddr{IP: target.binIPAddress, Port: int(target.id.Port)}
+	if target.isIPv6 {
+		var cm *ipv6.ControlMessage
+		if ttl > 0 {
+			cm = &ipv6.ControlMessage{HopLimit: ttl}
+		}
+		if _, err := ipv6.NewPacketConn(conn).WriteTo(thisPinger.payload(wb.Bytes()), cm, udpAddr); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if ttl > 0 {
+		//only one sender goroutine per UDP target
+		if err := ipv4.NewPacketConn(conn).SetTTL(ttl); err != nil {
+			return err
+		}
+	}
+	if _, err := conn.WriteToUDP(thisPinger.payload(wb.Bytes()), udpAddr); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (thisPinger *Pinger) udpListener(ctx context.Context, wg *sync.WaitGroup, target probeTarget) {
+	defer wg.Done()
+	defer thisPinger.logger.Log(labelinglog.FlgDebug, "("+target.ipAddress+")"+" finish")
+
+	conn, ok := thisPinger.getUDPConn(target.id)
+	if !ok {
+		return
+	}
+	targetIP := NetIP2BinIPAddress(target.binIPAddress)
+
+	rb := make([]byte, responseMTU)
+	for {
+		select {
+		case <-ctx.Done():
+			thisPinger.logger.Log(labelinglog.FlgDebug, "("+target.ipAddress+")"+" stop request received")
+			return
+		default:
+		}
+
+		conn.SetReadDeadline(time.Now().Add(1 * time.Second))
+		n, peer, err := conn.ReadFromUDP(rb)
+		if err != nil {
+			if neterr, ok := err.(net.Error); ok && neterr.Timeout() {
+				continue
+			}
+			// unconnected socket, errors are not fatal
+			thisPinger.logger.Log(labelinglog.FlgDebug, "("+target.ipAddress+") "+err.Error())
+			continue
+		}
+		nowNanosec := time.Now().UnixNano()
+
+		if NetIP2BinIPAddress(peer.IP) != targetIP || peer.Port != int(target.id.Port) {
+			continue
+		}
+
+		res := icmpResponse{
+			peer:               &net.IPAddr{IP: peer.IP},
+			targetID:           target.id,
+			seq:                parseUDPData(rb[:n], thisPinger.icmpID),
+			resultType:         IcmpResultTypeReceive,
+			receiveTimeNanosec: nowNanosec,
+		}
+
+		thisPinger.sendResponse(res)
+	}
+}
+
+//parseUDPData returns seq of the probe, or -1 if the payload is not ours
+//(e.g. application reply which does not echo the payload)
+func parseUDPData(data []byte, icmpID int) int {
+	var udpData tUDPData
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &udpData); err != nil {
+		return -1
+	}
+	if udpData.ID != uint16(icmpID) {
+		return -1
+	}
+
+	return int(udpData.Seq)
+}
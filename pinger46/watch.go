@@ -0,0 +1,234 @@
+package pinger46
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+//IcmpResultType a
+type IcmpResultType uint8
+
+//IcmpResult a
+type IcmpResult struct {
+	ResultType             IcmpResultType
+	IcmpTargetID           TargetID
+	BinPeerIP              BinIPAddress
+	Seq                    int
+	TTL                    int
+	SendTimeUnixNanosec    int64
+	ReceiveTimeUnixNanosec int64
+}
+
+//IcmpResultType a
+const (
+	IcmpResultUnknown = IcmpResultType(iota)
+	IcmpResultTypeReceive
+	IcmpResultTypeReceiveAfterTimeout
+	IcmpResultTypeTTLExceeded
+	IcmpResultTypeTimeout
+	IcmpResultTypeRefused
+	IcmpResultTypePortUnreachable
+	IcmpResultTypeNetUnreachable
+	IcmpResultTypeHostUnreachable
+	IcmpResultTypeProtocolUnreachable
+	IcmpResultTypeAdminProhibited
+	IcmpResultTypeFragmentationNeeded
+	IcmpResultTypeDestinationUnreachable
+	IcmpResultTypeSourceQuench
+	IcmpResultTypeRedirect
+	IcmpResultTypeParameterProblem
+)
+
+//GetChIcmpResult a
+func (thisPinger *Pinger) GetChIcmpResult(cap int) <-chan IcmpResult {
+	ch := make(chan IcmpResult, cap)
+
+	thisPinger.chIcmpResultsSubscriber.Lock()
+	defer thisPinger.chIcmpResultsSubscriber.Unlock()
+	thisPinger.chIcmpResultsSubscriber.list = append(thisPinger.chIcmpResultsSubscriber.list, ch)
+
+	return ch
+}
+
+//ResizeChIcmpResult replaces ch from GetChIcmpResult with a larger one, nothing is sent to ch after the return
+func (thisPinger *Pinger) ResizeChIcmpResult(ch <-chan IcmpResult, newCap int) <-chan IcmpResult {
+	thisPinger.chIcmpResultsSubscriber.Lock()
+	defer thisPinger.chIcmpResultsSubscriber.Unlock()
+	for i, c := range thisPinger.chIcmpResultsSubscriber.list {
+		if c != ch {
+			continue
+		}
+		if cap(c) >= newCap {
+			return ch
+		}
+		newCh := make(chan IcmpResult, newCap)
+		thisPinger.chIcmpResultsSubscriber.list[i] = newCh
+		return newCh
+	}
+
+	return ch
+}
+
+//RttStatistics a
+type RttStatistics struct {
+	RttCount         int64
+	MinRttNanosec    int64
+	AvgRttNanosec    int64
+	MaxRttNanosec    int64
+	StddevRttNanosec int64
+	P50RttNanosec    int64
+	P95RttNanosec    int64
+	P99RttNanosec    int64
+	JitterNanosec    int64
+}
+
+//SuccessCount a
+type SuccessCount struct {
+	Count       int64
+	LossPercent float64
+	RttStatistics
+
+	//same order as Config.StatisticsWindowsSec
+	Windows []WindowStatistics
+}
+
+//WindowStatistics a
+type WindowStatistics struct {
+	WindowSec     int64
+	SentCount     int64
+	ReceivedCount int64
+	LossPercent   float64
+	RttStatistics
+}
+
+//SuccessCounts a
+type SuccessCounts map[TargetID]SuccessCount
+
+// GetSuccessCounts a
+func (thisPinger *Pinger) GetSuccessCounts() SuccessCounts {
+	count := make(SuccessCounts)
+	nowNanosec := time.Now().UnixNano()
+
+	thisPinger.targetsLock.RLock()
+	defer thisPinger.targetsLock.RUnlock()
+	for id, target := range thisPinger.statisticsData.targets {
+		(func() {
+			target.Lock()
+			defer target.Unlock()
+
+			sum := int64(0)
+			for _, list := range target.Res {
+				sum += list
+			}
+
+			rtts := make([]int64, 0, len(target.Rtt))
+			for _, rtt := range target.Rtt {
+				if rtt >= 0 {
+					rtts = append(rtts, rtt)
+				}
+			}
+
+			successCount := SuccessCount{
+				Count:         sum,
+				RttStatistics: rttStatistics(rtts),
+			}
+			successCount.LossPercent = target.lossPercent()
+			successCount.JitterNanosec = int64(target.jitterNanosec)
+
+			if len(thisPinger.config.StatisticsWindowsSec) > 0 {
+				successCount.Windows = make([]WindowStatistics, 0, len(thisPinger.config.StatisticsWindowsSec))
+				for _, windowSec := range thisPinger.config.StatisticsWindowsSec {
+					successCount.Windows = append(successCount.Windows, windowStatistics(target.samples, windowSec, nowNanosec))
+				}
+			}
+
+			count[id] = successCount
+		})()
+	}
+
+	return count
+}
+
+func windowStatistics(samples []tSample, windowSec int64, nowNanosec int64) WindowStatistics {
+	border := nowNanosec - windowSec*int64(time.Second)
+
+	rtts := make([]int64, 0)
+	sentCount := int64(0)
+	jitterNanosec := float64(0)
+	lastRttNanosec := int64(-1)
+	for _, sample := range samples {
+		if sample.sendTimeNanosec < border {
+			continue
+		}
+		sentCount++
+		if sample.rttNanosec < 0 {
+			continue
+		}
+		if lastRttNanosec >= 0 {
+			d := float64(sample.rttNanosec - lastRttNanosec)
+			if d < 0 {
+				d = -d
+			}
+			jitterNanosec += (d - jitterNanosec) / 16
+		}
+		lastRttNanosec = sample.rttNanosec
+		rtts = append(rtts, sample.rttNanosec)
+	}
+
+	res := WindowStatistics{
+		WindowSec:     windowSec,
+		SentCount:     sentCount,
+		ReceivedCount: int64(len(rtts)),
+		RttStatistics: rttStatistics(rtts),
+	}
+	if sentCount > 0 {
+		res.LossPercent = float64(sentCount-res.ReceivedCount) * 100 / float64(sentCount)
+	}
+	res.JitterNanosec = int64(jitterNanosec)
+
+	return res
+}
+
+func rttStatistics(rtts []int64) RttStatistics {
+	res := RttStatistics{
+		RttCount: int64(len(rtts)),
+	}
+	if len(rtts) <= 0 {
+		return res
+	}
+
+	sort.Slice(rtts, func(i, j int) bool { return rtts[i] < rtts[j] })
+
+	total := int64(0)
+	for _, rtt := range rtts {
+		total += rtt
+	}
+	avg := float64(total) / float64(len(rtts))
+
+	variance := float64(0)
+	for _, rtt := range rtts {
+		d := float64(rtt) - avg
+		variance += d * d
+	}
+	variance /= float64(len(rtts))
+
+	res.MinRttNanosec = rtts[0]
+	res.AvgRttNanosec = int64(avg)
+	res.MaxRttNanosec = rtts[len(rtts)-1]
+	res.StddevRttNanosec = int64(math.Sqrt(variance))
+	res.P50RttNanosec = percentile(rtts, 50)
+	res.P95RttNanosec = percentile(rtts, 95)
+	res.P99RttNanosec = percentile(rtts, 99)
+
+	return res
+}
+
+//percentile nearest-rank, sorted must be sorted and not empty
+func percentile(sorted []int64, p int) int64 {
+	rank := (len(sorted)*p + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,214 @@
+package pinger46
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []int64
+		p      int
+		want   int64
+	}{
+		{"single", []int64{7}, 50, 7},
+		{"single p99", []int64{7}, 99, 7},
+		{"p0 is min", []int64{1, 2, 3, 4}, 0, 1},
+		{"p50 even", []int64{1, 2, 3, 4}, 50, 2},
+		{"p50 odd", []int64{1, 2, 3, 4, 5}, 50, 3},
+		{"p95 of 20", []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, 95, 19},
+		{"p99 of 20", []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, 99, 20},
+		{"p100 is max", []int64{1, 2, 3, 4}, 100, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentile(tt.sorted, tt.p); got != tt.want {
+				t.Errorf("percentile(%v, %d) = %d, want %d", tt.sorted, tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRttStatistics(t *testing.T) {
+	tests := []struct {
+		name string
+		rtts []int64
+		want RttStatistics
+	}{
+		{
+			name: "empty",
+			rtts: []int64{},
+			want: RttStatistics{},
+		},
+		{
+			name: "single",
+			rtts: []int64{100},
+			want: RttStatistics{
+				RttCount:      1,
+				MinRttNanosec: 100,
+				AvgRttNanosec: 100,
+				MaxRttNanosec: 100,
+				P50RttNanosec: 100,
+				P95RttNanosec: 100,
+				P99RttNanosec: 100,
+			},
+		},
+		{
+			name: "unsorted",
+			rtts: []int64{400, 100, 300, 200},
+			want: RttStatistics{
+				RttCount:         4,
+				MinRttNanosec:    100,
+				AvgRttNanosec:    250,
+				MaxRttNanosec:    400,
+				StddevRttNanosec: 111,
+				P50RttNanosec:    200,
+				P95RttNanosec:    400,
+				P99RttNanosec:    400,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rttStatistics(tt.rtts); got != tt.want {
+				t.Errorf("rttStatistics() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuccessCountLossAndJitter(t *testing.T) {
+	tests := []struct {
+		name string
+		//-1 if lost
+		rtts       []int64
+		wantLoss   float64
+		wantJitter int64
+	}{
+		{"no result", []int64{}, 0, 0},
+		{"one lost is not diluted by empty slots", []int64{1000, -1}, 50, 0},
+		{"all lost", []int64{-1, -1}, 100, 0},
+		{"only the latest slots count", []int64{-1, -1, 1000, 1000, 1000, 1000}, 0, 0},
+		{"wrapped with loss", []int64{1000, 1000, 1000, 1000, -1, -1}, 50, 0},
+		{"jitter", []int64{1000, 2000}, 0, 62},
+		{"jitter skips lost", []int64{1000, -1, 2000, 1000}, 25, 121},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.StatisticsCountsNum = 4
+			pinger := New(1, config)
+			if err := pinger.AddTarget("127.0.0.1", ""); err != nil {
+				t.Fatal(err)
+			}
+			targetID := pinger.GetTargetsOrder()[0]
+
+			nowNanosec := time.Now().UnixNano()
+			for _, rtt := range tt.rtts {
+				res := int64(1)
+				if rtt < 0 {
+					res = 0
+				}
+				pinger.addResult(targetID, res, nowNanosec, rtt)
+			}
+
+			count := pinger.GetSuccessCounts()[targetID]
+			if count.LossPercent != tt.wantLoss {
+				t.Errorf("LossPercent = %v, want %v", count.LossPercent, tt.wantLoss)
+			}
+			if count.JitterNanosec != tt.wantJitter {
+				t.Errorf("JitterNanosec = %d, want %d", count.JitterNanosec, tt.wantJitter)
+			}
+		})
+	}
+}
+
+func TestWindowStatistics(t *testing.T) {
+	nowNanosec := int64(1000) * int64(time.Second)
+	ago := func(sec int64) int64 {
+		return nowNanosec - sec*int64(time.Second)
+	}
+
+	tests := []struct {
+		name      string
+		samples   []tSample
+		windowSec int64
+		want      WindowStatistics
+	}{
+		{
+			name:      "empty",
+			samples:   []tSample{},
+			windowSec: 10,
+			want:      WindowStatistics{WindowSec: 10},
+		},
+		{
+			name: "loss in window",
+			samples: []tSample{
+				{sendTimeNanosec: ago(2), rttNanosec: -1},
+				{sendTimeNanosec: ago(1), rttNanosec: 100},
+			},
+			windowSec: 10,
+			want: WindowStatistics{
+				WindowSec:     10,
+				SentCount:     2,
+				ReceivedCount: 1,
+				LossPercent:   50,
+				RttStatistics: RttStatistics{
+					RttCount:      1,
+					MinRttNanosec: 100,
+					AvgRttNanosec: 100,
+					MaxRttNanosec: 100,
+					P50RttNanosec: 100,
+					P95RttNanosec: 100,
+					P99RttNanosec: 100,
+				},
+			},
+		},
+		{
+			name: "older samples are excluded and the border is included",
+			samples: []tSample{
+				{sendTimeNanosec: ago(20), rttNanosec: -1},
+				{sendTimeNanosec: ago(11), rttNanosec: 900},
+				{sendTimeNanosec: ago(10), rttNanosec: 100},
+				{sendTimeNanosec: ago(1), rttNanosec: 300},
+			},
+			windowSec: 10,
+			want: WindowStatistics{
+				WindowSec:     10,
+				SentCount:     2,
+				ReceivedCount: 2,
+				RttStatistics: RttStatistics{
+					RttCount:         2,
+					MinRttNanosec:    100,
+					AvgRttNanosec:    200,
+					MaxRttNanosec:    300,
+					StddevRttNanosec: 100,
+					P50RttNanosec:    100,
+					P95RttNanosec:    300,
+					P99RttNanosec:    300,
+					JitterNanosec:    12,
+				},
+			},
+		},
+		{
+			name: "nothing in window",
+			samples: []tSample{
+				{sendTimeNanosec: ago(30), rttNanosec: 100},
+			},
+			windowSec: 10,
+			want:      WindowStatistics{WindowSec: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := windowStatistics(tt.samples, tt.windowSec, nowNanosec); got != tt.want {
+				t.Errorf("windowStatistics() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
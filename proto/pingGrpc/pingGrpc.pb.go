@@ -24,30 +24,360 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ProbeType int32
+
+const (
+	ProbeType_ProbeTypeICMP ProbeType = 0
+	ProbeType_ProbeTypeTCP  ProbeType = 1
+	ProbeType_ProbeTypeUDP  ProbeType = 2
+)
+
+var ProbeType_name = map[int32]string{
+	0: "ProbeTypeICMP",
+	1: "ProbeTypeTCP",
+	2: "ProbeTypeUDP",
+}
+
+var ProbeType_value = map[string]int32{
+	"ProbeTypeICMP": 0,
+	"ProbeTypeTCP":  1,
+	"ProbeTypeUDP":  2,
+}
+
+func (x ProbeType) String() string {
+	return proto.EnumName(ProbeType_name, int32(x))
+}
+
+func (ProbeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{0}
+}
+
+type PingerMode int32
+
+const (
+	PingerMode_PingerModePing  PingerMode = 0
+	PingerMode_PingerModeTrace PingerMode = 1
+)
+
+var PingerMode_name = map[int32]string{
+	0: "PingerModePing",
+	1: "PingerModeTrace",
+}
+
+var PingerMode_value = map[string]int32{
+	"PingerModePing":  0,
+	"PingerModeTrace": 1,
+}
+
+func (x PingerMode) String() string {
+	return proto.EnumName(PingerMode_name, int32(x))
+}
+
+func (PingerMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{1}
+}
+
+type TargetState int32
+
+const (
+	TargetState_TargetStateUnknown  TargetState = 0
+	TargetState_TargetStateUp       TargetState = 1
+	TargetState_TargetStateDegraded TargetState = 2
+	TargetState_TargetStateDown     TargetState = 3
+)
+
+var TargetState_name = map[int32]string{
+	0: "TargetStateUnknown",
+	1: "TargetStateUp",
+	2: "TargetStateDegraded",
+	3: "TargetStateDown",
+}
+
+var TargetState_value = map[string]int32{
+	"TargetStateUnknown":  0,
+	"TargetStateUp":       1,
+	"TargetStateDegraded": 2,
+	"TargetStateDown":     3,
+}
+
+func (x TargetState) String() string {
+	return proto.EnumName(TargetState_name, int32(x))
+}
+
+func (TargetState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{2}
+}
+
+type StreamDropPolicy int32
+
+const (
+	StreamDropPolicy_StreamDropPolicyDropNewest StreamDropPolicy = 0
+	StreamDropPolicy_StreamDropPolicyDropOldest StreamDropPolicy = 1
+	StreamDropPolicy_StreamDropPolicyBlock      StreamDropPolicy = 2
+	StreamDropPolicy_StreamDropPolicyDisconnect StreamDropPolicy = 3
+)
+
+var StreamDropPolicy_name = map[int32]string{
+	0: "StreamDropPolicyDropNewest",
+	1: "StreamDropPolicyDropOldest",
+	2: "StreamDropPolicyBlock",
+	3: "StreamDropPolicyDisconnect",
+}
+
+var StreamDropPolicy_value = map[string]int32{
+	"StreamDropPolicyDropNewest": 0,
+	"StreamDropPolicyDropOldest": 1,
+	"StreamDropPolicyBlock":      2,
+	"StreamDropPolicyDisconnect": 3,
+}
+
+func (x StreamDropPolicy) String() string {
+	return proto.EnumName(StreamDropPolicy_name, int32(x))
+}
+
+func (StreamDropPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{3}
+}
+
+type TerminationReason int32
+
+const (
+	TerminationReason_TerminationReasonUnknown        TerminationReason = 0
+	TerminationReason_TerminationReasonExpired        TerminationReason = 1
+	TerminationReason_TerminationReasonStopped        TerminationReason = 2
+	TerminationReason_TerminationReasonCompleted      TerminationReason = 3
+	TerminationReason_TerminationReasonFailed         TerminationReason = 4
+	TerminationReason_TerminationReasonServerShutdown TerminationReason = 5
+)
+
+var TerminationReason_name = map[int32]string{
+	0: "TerminationReasonUnknown",
+	1: "TerminationReasonExpired",
+	2: "TerminationReasonStopped",
+	3: "TerminationReasonCompleted",
+	4: "TerminationReasonFailed",
+	5: "TerminationReasonServerShutdown",
+}
+
+var TerminationReason_value = map[string]int32{
+	"TerminationReasonUnknown":        0,
+	"TerminationReasonExpired":        1,
+	"TerminationReasonStopped":        2,
+	"TerminationReasonCompleted":      3,
+	"TerminationReasonFailed":         4,
+	"TerminationReasonServerShutdown": 5,
+}
+
+func (x TerminationReason) String() string {
+	return proto.EnumName(TerminationReason_name, int32(x))
+}
+
+func (TerminationReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{4}
+}
+
+type PingerEvent_EventType int32
+
+const (
+	PingerEvent_PingerEventTypeUnknown PingerEvent_EventType = 0
+	PingerEvent_PingerEventTypeCreated PingerEvent_EventType = 1
+	PingerEvent_PingerEventTypeStarted PingerEvent_EventType = 2
+	PingerEvent_PingerEventTypeUpdated PingerEvent_EventType = 3
+	PingerEvent_PingerEventTypePaused  PingerEvent_EventType = 4
+	PingerEvent_PingerEventTypeResumed PingerEvent_EventType = 5
+	PingerEvent_PingerEventTypeStopped PingerEvent_EventType = 6
+	PingerEvent_PingerEventTypeExpired PingerEvent_EventType = 7
+	PingerEvent_PingerEventTypeGap     PingerEvent_EventType = 8
+)
+
+var PingerEvent_EventType_name = map[int32]string{
+	0: "PingerEventTypeUnknown",
+	1: "PingerEventTypeCreated",
+	2: "PingerEventTypeStarted",
+	3: "PingerEventTypeUpdated",
+	4: "PingerEventTypePaused",
+	5: "PingerEventTypeResumed",
+	6: "PingerEventTypeStopped",
+	7: "PingerEventTypeExpired",
+	8: "PingerEventTypeGap",
+}
+
+var PingerEvent_EventType_value = map[string]int32{
+	"PingerEventTypeUnknown": 0,
+	"PingerEventTypeCreated": 1,
+	"PingerEventTypeStarted": 2,
+	"PingerEventTypeUpdated": 3,
+	"PingerEventTypePaused":  4,
+	"PingerEventTypeResumed": 5,
+	"PingerEventTypeStopped": 6,
+	"PingerEventTypeExpired": 7,
+	"PingerEventTypeGap":     8,
+}
+
+func (x PingerEvent_EventType) String() string {
+	return proto.EnumName(PingerEvent_EventType_name, int32(x))
+}
+
+func (PingerEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{8, 0}
+}
+
+type PingerWatchMessage_MessageType int32
+
+const (
+	PingerWatchMessage_PingerWatchMessageTypeUnknown  PingerWatchMessage_MessageType = 0
+	PingerWatchMessage_PingerWatchMessageTypeSnapshot PingerWatchMessage_MessageType = 1
+	PingerWatchMessage_PingerWatchMessageTypeEvent    PingerWatchMessage_MessageType = 2
+)
+
+var PingerWatchMessage_MessageType_name = map[int32]string{
+	0: "PingerWatchMessageTypeUnknown",
+	1: "PingerWatchMessageTypeSnapshot",
+	2: "PingerWatchMessageTypeEvent",
+}
+
+var PingerWatchMessage_MessageType_value = map[string]int32{
+	"PingerWatchMessageTypeUnknown":  0,
+	"PingerWatchMessageTypeSnapshot": 1,
+	"PingerWatchMessageTypeEvent":    2,
+}
+
+func (x PingerWatchMessage_MessageType) String() string {
+	return proto.EnumName(PingerWatchMessage_MessageType_name, int32(x))
+}
+
+func (PingerWatchMessage_MessageType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{9, 0}
+}
+
+type SubscribeRequest_ActionType int32
+
+const (
+	SubscribeRequest_SubscribeActionAdd    SubscribeRequest_ActionType = 0
+	SubscribeRequest_SubscribeActionRemove SubscribeRequest_ActionType = 1
+)
+
+var SubscribeRequest_ActionType_name = map[int32]string{
+	0: "SubscribeActionAdd",
+	1: "SubscribeActionRemove",
+}
+
+var SubscribeRequest_ActionType_value = map[string]int32{
+	"SubscribeActionAdd":    0,
+	"SubscribeActionRemove": 1,
+}
+
+func (x SubscribeRequest_ActionType) String() string {
+	return proto.EnumName(SubscribeRequest_ActionType_name, int32(x))
+}
+
+func (SubscribeRequest_ActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{10, 0}
+}
+
+type SubscribeMessage_MessageType int32
+
+const (
+	SubscribeMessage_SubscribeMessageTypeUnknown      SubscribeMessage_MessageType = 0
+	SubscribeMessage_SubscribeMessageTypeResult       SubscribeMessage_MessageType = 1
+	SubscribeMessage_SubscribeMessageTypeStatistics   SubscribeMessage_MessageType = 2
+	SubscribeMessage_SubscribeMessageTypeEvent        SubscribeMessage_MessageType = 3
+	SubscribeMessage_SubscribeMessageTypeSubscribed   SubscribeMessage_MessageType = 4
+	SubscribeMessage_SubscribeMessageTypeUnsubscribed SubscribeMessage_MessageType = 5
+	SubscribeMessage_SubscribeMessageTypeError        SubscribeMessage_MessageType = 6
+)
+
+var SubscribeMessage_MessageType_name = map[int32]string{
+	0: "SubscribeMessageTypeUnknown",
+	1: "SubscribeMessageTypeResult",
+	2: "SubscribeMessageTypeStatistics",
+	3: "SubscribeMessageTypeEvent",
+	4: "SubscribeMessageTypeSubscribed",
+	5: "SubscribeMessageTypeUnsubscribed",
+	6: "SubscribeMessageTypeError",
+}
+
+var SubscribeMessage_MessageType_value = map[string]int32{
+	"SubscribeMessageTypeUnknown":      0,
+	"SubscribeMessageTypeResult":       1,
+	"SubscribeMessageTypeStatistics":   2,
+	"SubscribeMessageTypeEvent":        3,
+	"SubscribeMessageTypeSubscribed":   4,
+	"SubscribeMessageTypeUnsubscribed": 5,
+	"SubscribeMessageTypeError":        6,
+}
+
+func (x SubscribeMessage_MessageType) String() string {
+	return proto.EnumName(SubscribeMessage_MessageType_name, int32(x))
+}
+
+func (SubscribeMessage_MessageType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{11, 0}
+}
+
 type IcmpResult_ResultType int32
 
 const (
-	IcmpResult_IcmpResultTypeUnknown             IcmpResult_ResultType = 0
-	IcmpResult_IcmpResultTypeReceive             IcmpResult_ResultType = 1
-	IcmpResult_IcmpResultTypeReceiveAfterTimeout IcmpResult_ResultType = 2
-	IcmpResult_IcmpResultTypeTTLExceeded         IcmpResult_ResultType = 3
-	IcmpResult_IcmpResultTypeTimeout             IcmpResult_ResultType = 4
+	IcmpResult_IcmpResultTypeUnknown                IcmpResult_ResultType = 0
+	IcmpResult_IcmpResultTypeReceive                IcmpResult_ResultType = 1
+	IcmpResult_IcmpResultTypeReceiveAfterTimeout    IcmpResult_ResultType = 2
+	IcmpResult_IcmpResultTypeTTLExceeded            IcmpResult_ResultType = 3
+	IcmpResult_IcmpResultTypeTimeout                IcmpResult_ResultType = 4
+	IcmpResult_IcmpResultTypeRefused                IcmpResult_ResultType = 5
+	IcmpResult_IcmpResultTypePortUnreachable        IcmpResult_ResultType = 6
+	IcmpResult_IcmpResultTypeNetUnreachable         IcmpResult_ResultType = 7
+	IcmpResult_IcmpResultTypeHostUnreachable        IcmpResult_ResultType = 8
+	IcmpResult_IcmpResultTypeProtocolUnreachable    IcmpResult_ResultType = 9
+	IcmpResult_IcmpResultTypeAdminProhibited        IcmpResult_ResultType = 10
+	IcmpResult_IcmpResultTypeFragmentationNeeded    IcmpResult_ResultType = 11
+	IcmpResult_IcmpResultTypeDestinationUnreachable IcmpResult_ResultType = 12
+	IcmpResult_IcmpResultTypeSourceQuench           IcmpResult_ResultType = 13
+	IcmpResult_IcmpResultTypeRedirect               IcmpResult_ResultType = 14
+	IcmpResult_IcmpResultTypeParameterProblem       IcmpResult_ResultType = 15
+	IcmpResult_IcmpResultTypeSummary                IcmpResult_ResultType = 16
+	IcmpResult_IcmpResultTypeGap                    IcmpResult_ResultType = 17
 )
 
 var IcmpResult_ResultType_name = map[int32]string{
-	0: "IcmpResultTypeUnknown",
-	1: "IcmpResultTypeReceive",
-	2: "IcmpResultTypeReceiveAfterTimeout",
-	3: "IcmpResultTypeTTLExceeded",
-	4: "IcmpResultTypeTimeout",
+	0:  "IcmpResultTypeUnknown",
+	1:  "IcmpResultTypeReceive",
+	2:  "IcmpResultTypeReceiveAfterTimeout",
+	3:  "IcmpResultTypeTTLExceeded",
+	4:  "IcmpResultTypeTimeout",
+	5:  "IcmpResultTypeRefused",
+	6:  "IcmpResultTypePortUnreachable",
+	7:  "IcmpResultTypeNetUnreachable",
+	8:  "IcmpResultTypeHostUnreachable",
+	9:  "IcmpResultTypeProtocolUnreachable",
+	10: "IcmpResultTypeAdminProhibited",
+	11: "IcmpResultTypeFragmentationNeeded",
+	12: "IcmpResultTypeDestinationUnreachable",
+	13: "IcmpResultTypeSourceQuench",
+	14: "IcmpResultTypeRedirect",
+	15: "IcmpResultTypeParameterProblem",
+	16: "IcmpResultTypeSummary",
+	17: "IcmpResultTypeGap",
 }
 
 var IcmpResult_ResultType_value = map[string]int32{
-	"IcmpResultTypeUnknown":             0,
-	"IcmpResultTypeReceive":             1,
-	"IcmpResultTypeReceiveAfterTimeout": 2,
-	"IcmpResultTypeTTLExceeded":         3,
-	"IcmpResultTypeTimeout":             4,
+	"IcmpResultTypeUnknown":                0,
+	"IcmpResultTypeReceive":                1,
+	"IcmpResultTypeReceiveAfterTimeout":    2,
+	"IcmpResultTypeTTLExceeded":            3,
+	"IcmpResultTypeTimeout":                4,
+	"IcmpResultTypeRefused":                5,
+	"IcmpResultTypePortUnreachable":        6,
+	"IcmpResultTypeNetUnreachable":         7,
+	"IcmpResultTypeHostUnreachable":        8,
+	"IcmpResultTypeProtocolUnreachable":    9,
+	"IcmpResultTypeAdminProhibited":        10,
+	"IcmpResultTypeFragmentationNeeded":    11,
+	"IcmpResultTypeDestinationUnreachable": 12,
+	"IcmpResultTypeSourceQuench":           13,
+	"IcmpResultTypeRedirect":               14,
+	"IcmpResultTypeParameterProblem":       15,
+	"IcmpResultTypeSummary":                16,
+	"IcmpResultTypeGap":                    17,
 }
 
 func (x IcmpResult_ResultType) String() string {
@@ -55,7 +385,38 @@ func (x IcmpResult_ResultType) String() string {
 }
 
 func (IcmpResult_ResultType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{6, 0}
+	return fileDescriptor_b912ac693319c27c, []int{14, 0}
+}
+
+type HopTable_HopTableType int32
+
+const (
+	HopTable_HopTableTypeTable      HopTable_HopTableType = 0
+	HopTable_HopTableTypePathChange HopTable_HopTableType = 1
+	HopTable_HopTableTypeSummary    HopTable_HopTableType = 2
+	HopTable_HopTableTypeGap        HopTable_HopTableType = 3
+)
+
+var HopTable_HopTableType_name = map[int32]string{
+	0: "HopTableTypeTable",
+	1: "HopTableTypePathChange",
+	2: "HopTableTypeSummary",
+	3: "HopTableTypeGap",
+}
+
+var HopTable_HopTableType_value = map[string]int32{
+	"HopTableTypeTable":      0,
+	"HopTableTypePathChange": 1,
+	"HopTableTypeSummary":    2,
+	"HopTableTypeGap":        3,
+}
+
+func (x HopTable_HopTableType) String() string {
+	return proto.EnumName(HopTable_HopTableType_name, int32(x))
+}
+
+func (HopTable_HopTableType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{15, 0}
 }
 
 type Null struct {
@@ -89,6 +450,188 @@ func (m *Null) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Null proto.InternalMessageInfo
 
+type PingerSummary struct {
+	Handle               string                       `protobuf:"bytes,1,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	Reason               TerminationReason            `protobuf:"varint,2,opt,name=Reason,proto3,enum=uPinger.TerminationReason" json:"Reason,omitempty"`
+	StoppedBy            string                       `protobuf:"bytes,3,opt,name=StoppedBy,proto3" json:"StoppedBy,omitempty"`
+	Error                string                       `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	StartUnixNanosec     uint64                       `protobuf:"varint,5,opt,name=StartUnixNanosec,proto3" json:"StartUnixNanosec,omitempty"`
+	EndUnixNanosec       uint64                       `protobuf:"varint,6,opt,name=EndUnixNanosec,proto3" json:"EndUnixNanosec,omitempty"`
+	Targets              []*PingerSummary_TargetTotal `protobuf:"bytes,7,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *PingerSummary) Reset()         { *m = PingerSummary{} }
+func (m *PingerSummary) String() string { return proto.CompactTextString(m) }
+func (*PingerSummary) ProtoMessage()    {}
+func (*PingerSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{1}
+}
+
+func (m *PingerSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerSummary.Unmarshal(m, b)
+}
+func (m *PingerSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerSummary.Marshal(b, m, deterministic)
+}
+func (m *PingerSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerSummary.Merge(m, src)
+}
+func (m *PingerSummary) XXX_Size() int {
+	return xxx_messageInfo_PingerSummary.Size(m)
+}
+func (m *PingerSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerSummary proto.InternalMessageInfo
+
+func (m *PingerSummary) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *PingerSummary) GetReason() TerminationReason {
+	if m != nil {
+		return m.Reason
+	}
+	return TerminationReason_TerminationReasonUnknown
+}
+
+func (m *PingerSummary) GetStoppedBy() string {
+	if m != nil {
+		return m.StoppedBy
+	}
+	return ""
+}
+
+func (m *PingerSummary) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PingerSummary) GetStartUnixNanosec() uint64 {
+	if m != nil {
+		return m.StartUnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerSummary) GetEndUnixNanosec() uint64 {
+	if m != nil {
+		return m.EndUnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerSummary) GetTargets() []*PingerSummary_TargetTotal {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+type PingerSummary_TargetTotal struct {
+	TargetID             uint32    `protobuf:"fixed32,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	TargetID128          []byte    `protobuf:"bytes,2,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	TargetIP             string    `protobuf:"bytes,3,opt,name=TargetIP,proto3" json:"TargetIP,omitempty"`
+	ProbeType            ProbeType `protobuf:"varint,4,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                 uint32    `protobuf:"varint,5,opt,name=Port,proto3" json:"Port,omitempty"`
+	SentCount            uint64    `protobuf:"varint,6,opt,name=SentCount,proto3" json:"SentCount,omitempty"`
+	ReceivedCount        uint64    `protobuf:"varint,7,opt,name=ReceivedCount,proto3" json:"ReceivedCount,omitempty"`
+	LossPercent          float64   `protobuf:"fixed64,8,opt,name=LossPercent,proto3" json:"LossPercent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PingerSummary_TargetTotal) Reset()         { *m = PingerSummary_TargetTotal{} }
+func (m *PingerSummary_TargetTotal) String() string { return proto.CompactTextString(m) }
+func (*PingerSummary_TargetTotal) ProtoMessage()    {}
+func (*PingerSummary_TargetTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{1, 0}
+}
+
+func (m *PingerSummary_TargetTotal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerSummary_TargetTotal.Unmarshal(m, b)
+}
+func (m *PingerSummary_TargetTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerSummary_TargetTotal.Marshal(b, m, deterministic)
+}
+func (m *PingerSummary_TargetTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerSummary_TargetTotal.Merge(m, src)
+}
+func (m *PingerSummary_TargetTotal) XXX_Size() int {
+	return xxx_messageInfo_PingerSummary_TargetTotal.Size(m)
+}
+func (m *PingerSummary_TargetTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerSummary_TargetTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerSummary_TargetTotal proto.InternalMessageInfo
+
+func (m *PingerSummary_TargetTotal) GetTargetID() uint32 {
+	if m != nil {
+		return m.TargetID
+	}
+	return 0
+}
+
+func (m *PingerSummary_TargetTotal) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
+}
+
+func (m *PingerSummary_TargetTotal) GetTargetIP() string {
+	if m != nil {
+		return m.TargetIP
+	}
+	return ""
+}
+
+func (m *PingerSummary_TargetTotal) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
+}
+
+func (m *PingerSummary_TargetTotal) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *PingerSummary_TargetTotal) GetSentCount() uint64 {
+	if m != nil {
+		return m.SentCount
+	}
+	return 0
+}
+
+func (m *PingerSummary_TargetTotal) GetReceivedCount() uint64 {
+	if m != nil {
+		return m.ReceivedCount
+	}
+	return 0
+}
+
+func (m *PingerSummary_TargetTotal) GetLossPercent() float64 {
+	if m != nil {
+		return m.LossPercent
+	}
+	return 0
+}
+
 type StartRequest struct {
 	Description           string                     `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
 	Targets               []*StartRequest_IcmpTarget `protobuf:"bytes,2,rep,name=Targets,proto3" json:"Targets,omitempty"`
@@ -97,6 +640,21 @@ type StartRequest struct {
 	StatisticsCountsNum   uint64                     `protobuf:"varint,5,opt,name=StatisticsCountsNum,proto3" json:"StatisticsCountsNum,omitempty"`
 	StopPingerSec         uint64                     `protobuf:"varint,6,opt,name=StopPingerSec,proto3" json:"StopPingerSec,omitempty"`
 	StatisticsIntervalSec uint64                     `protobuf:"varint,7,opt,name=StatisticsIntervalSec,proto3" json:"StatisticsIntervalSec,omitempty"`
+	Mode                  PingerMode                 `protobuf:"varint,8,opt,name=Mode,proto3,enum=uPinger.PingerMode" json:"Mode,omitempty"`
+	MaxHops               uint64                     `protobuf:"varint,9,opt,name=MaxHops,proto3" json:"MaxHops,omitempty"`
+	TTL                   uint64                     `protobuf:"varint,10,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	TOS                   uint64                     `protobuf:"varint,11,opt,name=TOS,proto3" json:"TOS,omitempty"`
+	PayloadSize           uint64                     `protobuf:"varint,12,opt,name=PayloadSize,proto3" json:"PayloadSize,omitempty"`
+	PayloadPattern        []byte                     `protobuf:"bytes,13,opt,name=PayloadPattern,proto3" json:"PayloadPattern,omitempty"`
+	DontFragment          bool                       `protobuf:"varint,14,opt,name=DontFragment,proto3" json:"DontFragment,omitempty"`
+	StatisticsWindowsSec  []uint64                   `protobuf:"varint,15,rep,packed,name=StatisticsWindowsSec,proto3" json:"StatisticsWindowsSec,omitempty"`
+	DownLossCount         uint64                     `protobuf:"varint,16,opt,name=DownLossCount,proto3" json:"DownLossCount,omitempty"`
+	UpSuccessCount        uint64                     `protobuf:"varint,17,opt,name=UpSuccessCount,proto3" json:"UpSuccessCount,omitempty"`
+	DegradedLossPercent   float64                    `protobuf:"fixed64,18,opt,name=DegradedLossPercent,proto3" json:"DegradedLossPercent,omitempty"`
+	HoldDownMillisec      uint64                     `protobuf:"varint,19,opt,name=HoldDownMillisec,proto3" json:"HoldDownMillisec,omitempty"`
+	Strict                bool                       `protobuf:"varint,20,opt,name=Strict,proto3" json:"Strict,omitempty"`
+	Count                 uint64                     `protobuf:"varint,21,opt,name=Count,proto3" json:"Count,omitempty"`
+	ReplayBufferSize      uint64                     `protobuf:"varint,22,opt,name=ReplayBufferSize,proto3" json:"ReplayBufferSize,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}                   `json:"-"`
 	XXX_unrecognized      []byte                     `json:"-"`
 	XXX_sizecache         int32                      `json:"-"`
@@ -106,7 +664,7 @@ func (m *StartRequest) Reset()         { *m = StartRequest{} }
 func (m *StartRequest) String() string { return proto.CompactTextString(m) }
 func (*StartRequest) ProtoMessage()    {}
 func (*StartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{1}
+	return fileDescriptor_b912ac693319c27c, []int{2}
 }
 
 func (m *StartRequest) XXX_Unmarshal(b []byte) error {
@@ -176,26 +734,131 @@ func (m *StartRequest) GetStatisticsIntervalSec() uint64 {
 	return 0
 }
 
-type StartRequest_IcmpTarget struct {
-	TargetIP             string   `protobuf:"bytes,1,opt,name=TargetIP,proto3" json:"TargetIP,omitempty"`
-	Comment              string   `protobuf:"bytes,2,opt,name=Comment,proto3" json:"Comment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StartRequest) GetMode() PingerMode {
+	if m != nil {
+		return m.Mode
+	}
+	return PingerMode_PingerModePing
 }
 
-func (m *StartRequest_IcmpTarget) Reset()         { *m = StartRequest_IcmpTarget{} }
-func (m *StartRequest_IcmpTarget) String() string { return proto.CompactTextString(m) }
-func (*StartRequest_IcmpTarget) ProtoMessage()    {}
-func (*StartRequest_IcmpTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{1, 0}
+func (m *StartRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
 }
 
-func (m *StartRequest_IcmpTarget) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StartRequest_IcmpTarget.Unmarshal(m, b)
+func (m *StartRequest) GetTTL() uint64 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
 }
-func (m *StartRequest_IcmpTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StartRequest_IcmpTarget.Marshal(b, m, deterministic)
+
+func (m *StartRequest) GetTOS() uint64 {
+	if m != nil {
+		return m.TOS
+	}
+	return 0
+}
+
+func (m *StartRequest) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+func (m *StartRequest) GetPayloadPattern() []byte {
+	if m != nil {
+		return m.PayloadPattern
+	}
+	return nil
+}
+
+func (m *StartRequest) GetDontFragment() bool {
+	if m != nil {
+		return m.DontFragment
+	}
+	return false
+}
+
+func (m *StartRequest) GetStatisticsWindowsSec() []uint64 {
+	if m != nil {
+		return m.StatisticsWindowsSec
+	}
+	return nil
+}
+
+func (m *StartRequest) GetDownLossCount() uint64 {
+	if m != nil {
+		return m.DownLossCount
+	}
+	return 0
+}
+
+func (m *StartRequest) GetUpSuccessCount() uint64 {
+	if m != nil {
+		return m.UpSuccessCount
+	}
+	return 0
+}
+
+func (m *StartRequest) GetDegradedLossPercent() float64 {
+	if m != nil {
+		return m.DegradedLossPercent
+	}
+	return 0
+}
+
+func (m *StartRequest) GetHoldDownMillisec() uint64 {
+	if m != nil {
+		return m.HoldDownMillisec
+	}
+	return 0
+}
+
+func (m *StartRequest) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+func (m *StartRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *StartRequest) GetReplayBufferSize() uint64 {
+	if m != nil {
+		return m.ReplayBufferSize
+	}
+	return 0
+}
+
+type StartRequest_IcmpTarget struct {
+	TargetIP             string   `protobuf:"bytes,1,opt,name=TargetIP,proto3" json:"TargetIP,omitempty"`
+	Comment              string   `protobuf:"bytes,2,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartRequest_IcmpTarget) Reset()         { *m = StartRequest_IcmpTarget{} }
+func (m *StartRequest_IcmpTarget) String() string { return proto.CompactTextString(m) }
+func (*StartRequest_IcmpTarget) ProtoMessage()    {}
+func (*StartRequest_IcmpTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{2, 0}
+}
+
+func (m *StartRequest_IcmpTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartRequest_IcmpTarget.Unmarshal(m, b)
+}
+func (m *StartRequest_IcmpTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartRequest_IcmpTarget.Marshal(b, m, deterministic)
 }
 func (m *StartRequest_IcmpTarget) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_StartRequest_IcmpTarget.Merge(m, src)
@@ -225,6 +888,10 @@ func (m *StartRequest_IcmpTarget) GetComment() string {
 
 type Statistics struct {
 	Targets              []*Statistics_SuccessCount `protobuf:"bytes,1,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Final                bool                       `protobuf:"varint,2,opt,name=Final,proto3" json:"Final,omitempty"`
+	Summary              *PingerSummary             `protobuf:"bytes,3,opt,name=Summary,proto3" json:"Summary,omitempty"`
+	StreamSequence       uint64                     `protobuf:"varint,4,opt,name=StreamSequence,proto3" json:"StreamSequence,omitempty"`
+	DroppedCount         uint64                     `protobuf:"varint,5,opt,name=DroppedCount,proto3" json:"DroppedCount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -234,7 +901,7 @@ func (m *Statistics) Reset()         { *m = Statistics{} }
 func (m *Statistics) String() string { return proto.CompactTextString(m) }
 func (*Statistics) ProtoMessage()    {}
 func (*Statistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{2}
+	return fileDescriptor_b912ac693319c27c, []int{3}
 }
 
 func (m *Statistics) XXX_Unmarshal(b []byte) error {
@@ -262,19 +929,61 @@ func (m *Statistics) GetTargets() []*Statistics_SuccessCount {
 	return nil
 }
 
+func (m *Statistics) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+func (m *Statistics) GetSummary() *PingerSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *Statistics) GetStreamSequence() uint64 {
+	if m != nil {
+		return m.StreamSequence
+	}
+	return 0
+}
+
+func (m *Statistics) GetDroppedCount() uint64 {
+	if m != nil {
+		return m.DroppedCount
+	}
+	return 0
+}
+
 type Statistics_SuccessCount struct {
-	TargetID             uint32   `protobuf:"fixed32,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
-	Count                int64    `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TargetID             uint32                            `protobuf:"fixed32,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	Count                int64                             `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	TargetID128          []byte                            `protobuf:"bytes,3,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	ProbeType            ProbeType                         `protobuf:"varint,4,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                 uint32                            `protobuf:"varint,5,opt,name=Port,proto3" json:"Port,omitempty"`
+	LossPercent          float64                           `protobuf:"fixed64,6,opt,name=LossPercent,proto3" json:"LossPercent,omitempty"`
+	RttCount             int64                             `protobuf:"varint,7,opt,name=RttCount,proto3" json:"RttCount,omitempty"`
+	MinRttNanosec        int64                             `protobuf:"varint,8,opt,name=MinRttNanosec,proto3" json:"MinRttNanosec,omitempty"`
+	AvgRttNanosec        int64                             `protobuf:"varint,9,opt,name=AvgRttNanosec,proto3" json:"AvgRttNanosec,omitempty"`
+	MaxRttNanosec        int64                             `protobuf:"varint,10,opt,name=MaxRttNanosec,proto3" json:"MaxRttNanosec,omitempty"`
+	StddevRttNanosec     int64                             `protobuf:"varint,11,opt,name=StddevRttNanosec,proto3" json:"StddevRttNanosec,omitempty"`
+	P50RttNanosec        int64                             `protobuf:"varint,12,opt,name=P50RttNanosec,proto3" json:"P50RttNanosec,omitempty"`
+	P95RttNanosec        int64                             `protobuf:"varint,13,opt,name=P95RttNanosec,proto3" json:"P95RttNanosec,omitempty"`
+	P99RttNanosec        int64                             `protobuf:"varint,14,opt,name=P99RttNanosec,proto3" json:"P99RttNanosec,omitempty"`
+	JitterNanosec        int64                             `protobuf:"varint,15,opt,name=JitterNanosec,proto3" json:"JitterNanosec,omitempty"`
+	Windows              []*Statistics_SuccessCount_Window `protobuf:"bytes,16,rep,name=Windows,proto3" json:"Windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
 func (m *Statistics_SuccessCount) Reset()         { *m = Statistics_SuccessCount{} }
 func (m *Statistics_SuccessCount) String() string { return proto.CompactTextString(m) }
 func (*Statistics_SuccessCount) ProtoMessage()    {}
 func (*Statistics_SuccessCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{2, 0}
+	return fileDescriptor_b912ac693319c27c, []int{3, 0}
 }
 
 func (m *Statistics_SuccessCount) XXX_Unmarshal(b []byte) error {
@@ -309,210 +1018,633 @@ func (m *Statistics_SuccessCount) GetCount() int64 {
 	return 0
 }
 
-type PingerID struct {
-	PingerID             uint32   `protobuf:"varint,1,opt,name=PingerID,proto3" json:"PingerID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *PingerID) Reset()         { *m = PingerID{} }
-func (m *PingerID) String() string { return proto.CompactTextString(m) }
-func (*PingerID) ProtoMessage()    {}
-func (*PingerID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{3}
+func (m *Statistics_SuccessCount) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
 }
 
-func (m *PingerID) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingerID.Unmarshal(m, b)
-}
-func (m *PingerID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingerID.Marshal(b, m, deterministic)
-}
-func (m *PingerID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingerID.Merge(m, src)
-}
-func (m *PingerID) XXX_Size() int {
-	return xxx_messageInfo_PingerID.Size(m)
-}
-func (m *PingerID) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingerID.DiscardUnknown(m)
+func (m *Statistics_SuccessCount) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
 }
 
-var xxx_messageInfo_PingerID proto.InternalMessageInfo
-
-func (m *PingerID) GetPingerID() uint32 {
+func (m *Statistics_SuccessCount) GetPort() uint32 {
 	if m != nil {
-		return m.PingerID
+		return m.Port
 	}
 	return 0
 }
 
-type PingerList struct {
-	Pingers              []*PingerList_PingerSumally `protobuf:"bytes,1,rep,name=Pingers,proto3" json:"Pingers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *Statistics_SuccessCount) GetLossPercent() float64 {
+	if m != nil {
+		return m.LossPercent
+	}
+	return 0
 }
 
-func (m *PingerList) Reset()         { *m = PingerList{} }
-func (m *PingerList) String() string { return proto.CompactTextString(m) }
-func (*PingerList) ProtoMessage()    {}
-func (*PingerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{4}
+func (m *Statistics_SuccessCount) GetRttCount() int64 {
+	if m != nil {
+		return m.RttCount
+	}
+	return 0
 }
 
-func (m *PingerList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingerList.Unmarshal(m, b)
-}
-func (m *PingerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingerList.Marshal(b, m, deterministic)
-}
-func (m *PingerList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingerList.Merge(m, src)
-}
-func (m *PingerList) XXX_Size() int {
-	return xxx_messageInfo_PingerList.Size(m)
-}
-func (m *PingerList) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingerList.DiscardUnknown(m)
+func (m *Statistics_SuccessCount) GetMinRttNanosec() int64 {
+	if m != nil {
+		return m.MinRttNanosec
+	}
+	return 0
 }
 
-var xxx_messageInfo_PingerList proto.InternalMessageInfo
-
-func (m *PingerList) GetPingers() []*PingerList_PingerSumally {
+func (m *Statistics_SuccessCount) GetAvgRttNanosec() int64 {
 	if m != nil {
-		return m.Pingers
+		return m.AvgRttNanosec
 	}
-	return nil
+	return 0
 }
 
-type PingerList_PingerSumally struct {
-	PingerID             uint32   `protobuf:"varint,1,opt,name=PingerID,proto3" json:"PingerID,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	StartUnixNanosec     uint64   `protobuf:"varint,3,opt,name=StartUnixNanosec,proto3" json:"StartUnixNanosec,omitempty"`
-	ExpireUnixNanosec    uint64   `protobuf:"varint,4,opt,name=ExpireUnixNanosec,proto3" json:"ExpireUnixNanosec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Statistics_SuccessCount) GetMaxRttNanosec() int64 {
+	if m != nil {
+		return m.MaxRttNanosec
+	}
+	return 0
 }
 
-func (m *PingerList_PingerSumally) Reset()         { *m = PingerList_PingerSumally{} }
-func (m *PingerList_PingerSumally) String() string { return proto.CompactTextString(m) }
-func (*PingerList_PingerSumally) ProtoMessage()    {}
-func (*PingerList_PingerSumally) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{4, 0}
+func (m *Statistics_SuccessCount) GetStddevRttNanosec() int64 {
+	if m != nil {
+		return m.StddevRttNanosec
+	}
+	return 0
 }
 
-func (m *PingerList_PingerSumally) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingerList_PingerSumally.Unmarshal(m, b)
-}
-func (m *PingerList_PingerSumally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingerList_PingerSumally.Marshal(b, m, deterministic)
-}
-func (m *PingerList_PingerSumally) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingerList_PingerSumally.Merge(m, src)
-}
-func (m *PingerList_PingerSumally) XXX_Size() int {
-	return xxx_messageInfo_PingerList_PingerSumally.Size(m)
-}
-func (m *PingerList_PingerSumally) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingerList_PingerSumally.DiscardUnknown(m)
+func (m *Statistics_SuccessCount) GetP50RttNanosec() int64 {
+	if m != nil {
+		return m.P50RttNanosec
+	}
+	return 0
 }
 
-var xxx_messageInfo_PingerList_PingerSumally proto.InternalMessageInfo
-
-func (m *PingerList_PingerSumally) GetPingerID() uint32 {
+func (m *Statistics_SuccessCount) GetP95RttNanosec() int64 {
 	if m != nil {
-		return m.PingerID
+		return m.P95RttNanosec
 	}
 	return 0
 }
 
-func (m *PingerList_PingerSumally) GetDescription() string {
+func (m *Statistics_SuccessCount) GetP99RttNanosec() int64 {
 	if m != nil {
-		return m.Description
+		return m.P99RttNanosec
 	}
-	return ""
+	return 0
 }
 
-func (m *PingerList_PingerSumally) GetStartUnixNanosec() uint64 {
+func (m *Statistics_SuccessCount) GetJitterNanosec() int64 {
 	if m != nil {
-		return m.StartUnixNanosec
+		return m.JitterNanosec
 	}
 	return 0
 }
 
-func (m *PingerList_PingerSumally) GetExpireUnixNanosec() uint64 {
+func (m *Statistics_SuccessCount) GetWindows() []*Statistics_SuccessCount_Window {
 	if m != nil {
-		return m.ExpireUnixNanosec
+		return m.Windows
 	}
-	return 0
+	return nil
 }
 
-type PingerInfo struct {
-	Description           string                   `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
-	Targets               []*PingerInfo_IcmpTarget `protobuf:"bytes,2,rep,name=Targets,proto3" json:"Targets,omitempty"`
-	IntervalMillisec      uint64                   `protobuf:"varint,3,opt,name=IntervalMillisec,proto3" json:"IntervalMillisec,omitempty"`
-	TimeoutMillisec       uint64                   `protobuf:"varint,4,opt,name=TimeoutMillisec,proto3" json:"TimeoutMillisec,omitempty"`
-	StatisticsCountsNum   uint64                   `protobuf:"varint,5,opt,name=StatisticsCountsNum,proto3" json:"StatisticsCountsNum,omitempty"`
-	StatisticsIntervalSec uint64                   `protobuf:"varint,6,opt,name=StatisticsIntervalSec,proto3" json:"StatisticsIntervalSec,omitempty"`
-	StartUnixNanosec      uint64                   `protobuf:"varint,8,opt,name=StartUnixNanosec,proto3" json:"StartUnixNanosec,omitempty"`
-	ExpireUnixNanosec     uint64                   `protobuf:"varint,7,opt,name=ExpireUnixNanosec,proto3" json:"ExpireUnixNanosec,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                 `json:"-"`
-	XXX_unrecognized      []byte                   `json:"-"`
-	XXX_sizecache         int32                    `json:"-"`
+type Statistics_SuccessCount_Window struct {
+	WindowSec            uint64   `protobuf:"varint,1,opt,name=WindowSec,proto3" json:"WindowSec,omitempty"`
+	SentCount            int64    `protobuf:"varint,2,opt,name=SentCount,proto3" json:"SentCount,omitempty"`
+	ReceivedCount        int64    `protobuf:"varint,3,opt,name=ReceivedCount,proto3" json:"ReceivedCount,omitempty"`
+	LossPercent          float64  `protobuf:"fixed64,4,opt,name=LossPercent,proto3" json:"LossPercent,omitempty"`
+	RttCount             int64    `protobuf:"varint,5,opt,name=RttCount,proto3" json:"RttCount,omitempty"`
+	MinRttNanosec        int64    `protobuf:"varint,6,opt,name=MinRttNanosec,proto3" json:"MinRttNanosec,omitempty"`
+	AvgRttNanosec        int64    `protobuf:"varint,7,opt,name=AvgRttNanosec,proto3" json:"AvgRttNanosec,omitempty"`
+	MaxRttNanosec        int64    `protobuf:"varint,8,opt,name=MaxRttNanosec,proto3" json:"MaxRttNanosec,omitempty"`
+	StddevRttNanosec     int64    `protobuf:"varint,9,opt,name=StddevRttNanosec,proto3" json:"StddevRttNanosec,omitempty"`
+	P50RttNanosec        int64    `protobuf:"varint,10,opt,name=P50RttNanosec,proto3" json:"P50RttNanosec,omitempty"`
+	P95RttNanosec        int64    `protobuf:"varint,11,opt,name=P95RttNanosec,proto3" json:"P95RttNanosec,omitempty"`
+	P99RttNanosec        int64    `protobuf:"varint,12,opt,name=P99RttNanosec,proto3" json:"P99RttNanosec,omitempty"`
+	JitterNanosec        int64    `protobuf:"varint,13,opt,name=JitterNanosec,proto3" json:"JitterNanosec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PingerInfo) Reset()         { *m = PingerInfo{} }
-func (m *PingerInfo) String() string { return proto.CompactTextString(m) }
-func (*PingerInfo) ProtoMessage()    {}
-func (*PingerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{5}
+func (m *Statistics_SuccessCount_Window) Reset()         { *m = Statistics_SuccessCount_Window{} }
+func (m *Statistics_SuccessCount_Window) String() string { return proto.CompactTextString(m) }
+func (*Statistics_SuccessCount_Window) ProtoMessage()    {}
+func (*Statistics_SuccessCount_Window) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{3, 0, 0}
 }
 
-func (m *PingerInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingerInfo.Unmarshal(m, b)
+func (m *Statistics_SuccessCount_Window) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Statistics_SuccessCount_Window.Unmarshal(m, b)
 }
-func (m *PingerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingerInfo.Marshal(b, m, deterministic)
+func (m *Statistics_SuccessCount_Window) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Statistics_SuccessCount_Window.Marshal(b, m, deterministic)
 }
-func (m *PingerInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingerInfo.Merge(m, src)
+func (m *Statistics_SuccessCount_Window) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Statistics_SuccessCount_Window.Merge(m, src)
 }
-func (m *PingerInfo) XXX_Size() int {
-	return xxx_messageInfo_PingerInfo.Size(m)
+func (m *Statistics_SuccessCount_Window) XXX_Size() int {
+	return xxx_messageInfo_Statistics_SuccessCount_Window.Size(m)
 }
-func (m *PingerInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingerInfo.DiscardUnknown(m)
+func (m *Statistics_SuccessCount_Window) XXX_DiscardUnknown() {
+	xxx_messageInfo_Statistics_SuccessCount_Window.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingerInfo proto.InternalMessageInfo
+var xxx_messageInfo_Statistics_SuccessCount_Window proto.InternalMessageInfo
 
-func (m *PingerInfo) GetDescription() string {
+func (m *Statistics_SuccessCount_Window) GetWindowSec() uint64 {
 	if m != nil {
-		return m.Description
+		return m.WindowSec
 	}
-	return ""
+	return 0
 }
 
-func (m *PingerInfo) GetTargets() []*PingerInfo_IcmpTarget {
+func (m *Statistics_SuccessCount_Window) GetSentCount() int64 {
 	if m != nil {
-		return m.Targets
+		return m.SentCount
 	}
-	return nil
+	return 0
 }
 
-func (m *PingerInfo) GetIntervalMillisec() uint64 {
+func (m *Statistics_SuccessCount_Window) GetReceivedCount() int64 {
 	if m != nil {
-		return m.IntervalMillisec
+		return m.ReceivedCount
 	}
 	return 0
 }
 
-func (m *PingerInfo) GetTimeoutMillisec() uint64 {
+func (m *Statistics_SuccessCount_Window) GetLossPercent() float64 {
 	if m != nil {
-		return m.TimeoutMillisec
+		return m.LossPercent
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetRttCount() int64 {
+	if m != nil {
+		return m.RttCount
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetMinRttNanosec() int64 {
+	if m != nil {
+		return m.MinRttNanosec
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetAvgRttNanosec() int64 {
+	if m != nil {
+		return m.AvgRttNanosec
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetMaxRttNanosec() int64 {
+	if m != nil {
+		return m.MaxRttNanosec
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetStddevRttNanosec() int64 {
+	if m != nil {
+		return m.StddevRttNanosec
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetP50RttNanosec() int64 {
+	if m != nil {
+		return m.P50RttNanosec
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetP95RttNanosec() int64 {
+	if m != nil {
+		return m.P95RttNanosec
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetP99RttNanosec() int64 {
+	if m != nil {
+		return m.P99RttNanosec
+	}
+	return 0
+}
+
+func (m *Statistics_SuccessCount_Window) GetJitterNanosec() int64 {
+	if m != nil {
+		return m.JitterNanosec
+	}
+	return 0
+}
+
+type PingerID struct {
+	// Deprecated: Do not use.
+	PingerID             uint32   `protobuf:"varint,1,opt,name=PingerID,proto3" json:"PingerID,omitempty"`
+	Handle               string   `protobuf:"bytes,2,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingerID) Reset()         { *m = PingerID{} }
+func (m *PingerID) String() string { return proto.CompactTextString(m) }
+func (*PingerID) ProtoMessage()    {}
+func (*PingerID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{4}
+}
+
+func (m *PingerID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerID.Unmarshal(m, b)
+}
+func (m *PingerID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerID.Marshal(b, m, deterministic)
+}
+func (m *PingerID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerID.Merge(m, src)
+}
+func (m *PingerID) XXX_Size() int {
+	return xxx_messageInfo_PingerID.Size(m)
+}
+func (m *PingerID) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerID proto.InternalMessageInfo
+
+// Deprecated: Do not use.
+func (m *PingerID) GetPingerID() uint32 {
+	if m != nil {
+		return m.PingerID
+	}
+	return 0
+}
+
+func (m *PingerID) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+type StreamRequest struct {
+	// Deprecated: Do not use.
+	PingerID              uint32                     `protobuf:"varint,1,opt,name=PingerID,proto3" json:"PingerID,omitempty"`
+	Handle                string                     `protobuf:"bytes,2,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	ReplayFromSequence    uint64                     `protobuf:"varint,3,opt,name=ReplayFromSequence,proto3" json:"ReplayFromSequence,omitempty"`
+	ReplayFromUnixNanosec uint64                     `protobuf:"varint,4,opt,name=ReplayFromUnixNanosec,proto3" json:"ReplayFromUnixNanosec,omitempty"`
+	FilterTargets         []*StartRequest_IcmpTarget `protobuf:"bytes,5,rep,name=FilterTargets,proto3" json:"FilterTargets,omitempty"`
+	FilterNonReceiveOnly  bool                       `protobuf:"varint,6,opt,name=FilterNonReceiveOnly,proto3" json:"FilterNonReceiveOnly,omitempty"`
+	FilterMinRttNanosec   int64                      `protobuf:"varint,7,opt,name=FilterMinRttNanosec,proto3" json:"FilterMinRttNanosec,omitempty"`
+	FilterEveryNth        uint64                     `protobuf:"varint,8,opt,name=FilterEveryNth,proto3" json:"FilterEveryNth,omitempty"`
+	DropPolicy            StreamDropPolicy           `protobuf:"varint,9,opt,name=DropPolicy,proto3,enum=uPinger.StreamDropPolicy" json:"DropPolicy,omitempty"`
+	BlockTimeoutMillisec  uint64                     `protobuf:"varint,10,opt,name=BlockTimeoutMillisec,proto3" json:"BlockTimeoutMillisec,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                   `json:"-"`
+	XXX_unrecognized      []byte                     `json:"-"`
+	XXX_sizecache         int32                      `json:"-"`
+}
+
+func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
+func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamRequest) ProtoMessage()    {}
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{5}
+}
+
+func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
+}
+func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRequest.Merge(m, src)
+}
+func (m *StreamRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamRequest.Size(m)
+}
+func (m *StreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
+
+// Deprecated: Do not use.
+func (m *StreamRequest) GetPingerID() uint32 {
+	if m != nil {
+		return m.PingerID
+	}
+	return 0
+}
+
+func (m *StreamRequest) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *StreamRequest) GetReplayFromSequence() uint64 {
+	if m != nil {
+		return m.ReplayFromSequence
+	}
+	return 0
+}
+
+func (m *StreamRequest) GetReplayFromUnixNanosec() uint64 {
+	if m != nil {
+		return m.ReplayFromUnixNanosec
+	}
+	return 0
+}
+
+func (m *StreamRequest) GetFilterTargets() []*StartRequest_IcmpTarget {
+	if m != nil {
+		return m.FilterTargets
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetFilterNonReceiveOnly() bool {
+	if m != nil {
+		return m.FilterNonReceiveOnly
+	}
+	return false
+}
+
+func (m *StreamRequest) GetFilterMinRttNanosec() int64 {
+	if m != nil {
+		return m.FilterMinRttNanosec
+	}
+	return 0
+}
+
+func (m *StreamRequest) GetFilterEveryNth() uint64 {
+	if m != nil {
+		return m.FilterEveryNth
+	}
+	return 0
+}
+
+func (m *StreamRequest) GetDropPolicy() StreamDropPolicy {
+	if m != nil {
+		return m.DropPolicy
+	}
+	return StreamDropPolicy_StreamDropPolicyDropNewest
+}
+
+func (m *StreamRequest) GetBlockTimeoutMillisec() uint64 {
+	if m != nil {
+		return m.BlockTimeoutMillisec
+	}
+	return 0
+}
+
+type PingerList struct {
+	Pingers              []*PingerList_PingerSumally `protobuf:"bytes,1,rep,name=Pingers,proto3" json:"Pingers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *PingerList) Reset()         { *m = PingerList{} }
+func (m *PingerList) String() string { return proto.CompactTextString(m) }
+func (*PingerList) ProtoMessage()    {}
+func (*PingerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{6}
+}
+
+func (m *PingerList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerList.Unmarshal(m, b)
+}
+func (m *PingerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerList.Marshal(b, m, deterministic)
+}
+func (m *PingerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerList.Merge(m, src)
+}
+func (m *PingerList) XXX_Size() int {
+	return xxx_messageInfo_PingerList.Size(m)
+}
+func (m *PingerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerList proto.InternalMessageInfo
+
+func (m *PingerList) GetPingers() []*PingerList_PingerSumally {
+	if m != nil {
+		return m.Pingers
+	}
+	return nil
+}
+
+type PingerList_PingerSumally struct {
+	// Deprecated: Do not use.
+	PingerID             uint32   `protobuf:"varint,1,opt,name=PingerID,proto3" json:"PingerID,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	StartUnixNanosec     uint64   `protobuf:"varint,3,opt,name=StartUnixNanosec,proto3" json:"StartUnixNanosec,omitempty"`
+	ExpireUnixNanosec    uint64   `protobuf:"varint,4,opt,name=ExpireUnixNanosec,proto3" json:"ExpireUnixNanosec,omitempty"`
+	Paused               bool     `protobuf:"varint,5,opt,name=Paused,proto3" json:"Paused,omitempty"`
+	PausedUnixNanosec    uint64   `protobuf:"varint,6,opt,name=PausedUnixNanosec,proto3" json:"PausedUnixNanosec,omitempty"`
+	TotalPausedNanosec   uint64   `protobuf:"varint,7,opt,name=TotalPausedNanosec,proto3" json:"TotalPausedNanosec,omitempty"`
+	Handle               string   `protobuf:"bytes,8,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	IcmpID               uint32   `protobuf:"varint,9,opt,name=IcmpID,proto3" json:"IcmpID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingerList_PingerSumally) Reset()         { *m = PingerList_PingerSumally{} }
+func (m *PingerList_PingerSumally) String() string { return proto.CompactTextString(m) }
+func (*PingerList_PingerSumally) ProtoMessage()    {}
+func (*PingerList_PingerSumally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{6, 0}
+}
+
+func (m *PingerList_PingerSumally) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerList_PingerSumally.Unmarshal(m, b)
+}
+func (m *PingerList_PingerSumally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerList_PingerSumally.Marshal(b, m, deterministic)
+}
+func (m *PingerList_PingerSumally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerList_PingerSumally.Merge(m, src)
+}
+func (m *PingerList_PingerSumally) XXX_Size() int {
+	return xxx_messageInfo_PingerList_PingerSumally.Size(m)
+}
+func (m *PingerList_PingerSumally) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerList_PingerSumally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerList_PingerSumally proto.InternalMessageInfo
+
+// Deprecated: Do not use.
+func (m *PingerList_PingerSumally) GetPingerID() uint32 {
+	if m != nil {
+		return m.PingerID
+	}
+	return 0
+}
+
+func (m *PingerList_PingerSumally) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PingerList_PingerSumally) GetStartUnixNanosec() uint64 {
+	if m != nil {
+		return m.StartUnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerList_PingerSumally) GetExpireUnixNanosec() uint64 {
+	if m != nil {
+		return m.ExpireUnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerList_PingerSumally) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *PingerList_PingerSumally) GetPausedUnixNanosec() uint64 {
+	if m != nil {
+		return m.PausedUnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerList_PingerSumally) GetTotalPausedNanosec() uint64 {
+	if m != nil {
+		return m.TotalPausedNanosec
+	}
+	return 0
+}
+
+func (m *PingerList_PingerSumally) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *PingerList_PingerSumally) GetIcmpID() uint32 {
+	if m != nil {
+		return m.IcmpID
+	}
+	return 0
+}
+
+type PingerInfo struct {
+	Description              string                   `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
+	Targets                  []*PingerInfo_IcmpTarget `protobuf:"bytes,2,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	IntervalMillisec         uint64                   `protobuf:"varint,3,opt,name=IntervalMillisec,proto3" json:"IntervalMillisec,omitempty"`
+	TimeoutMillisec          uint64                   `protobuf:"varint,4,opt,name=TimeoutMillisec,proto3" json:"TimeoutMillisec,omitempty"`
+	StatisticsCountsNum      uint64                   `protobuf:"varint,5,opt,name=StatisticsCountsNum,proto3" json:"StatisticsCountsNum,omitempty"`
+	StatisticsIntervalSec    uint64                   `protobuf:"varint,6,opt,name=StatisticsIntervalSec,proto3" json:"StatisticsIntervalSec,omitempty"`
+	StartUnixNanosec         uint64                   `protobuf:"varint,8,opt,name=StartUnixNanosec,proto3" json:"StartUnixNanosec,omitempty"`
+	ExpireUnixNanosec        uint64                   `protobuf:"varint,7,opt,name=ExpireUnixNanosec,proto3" json:"ExpireUnixNanosec,omitempty"`
+	Mode                     PingerMode               `protobuf:"varint,9,opt,name=Mode,proto3,enum=uPinger.PingerMode" json:"Mode,omitempty"`
+	MaxHops                  uint64                   `protobuf:"varint,10,opt,name=MaxHops,proto3" json:"MaxHops,omitempty"`
+	TTL                      uint64                   `protobuf:"varint,11,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	TOS                      uint64                   `protobuf:"varint,12,opt,name=TOS,proto3" json:"TOS,omitempty"`
+	PayloadSize              uint64                   `protobuf:"varint,13,opt,name=PayloadSize,proto3" json:"PayloadSize,omitempty"`
+	PayloadPattern           []byte                   `protobuf:"bytes,14,opt,name=PayloadPattern,proto3" json:"PayloadPattern,omitempty"`
+	DontFragment             bool                     `protobuf:"varint,15,opt,name=DontFragment,proto3" json:"DontFragment,omitempty"`
+	StatisticsWindowsSec     []uint64                 `protobuf:"varint,16,rep,packed,name=StatisticsWindowsSec,proto3" json:"StatisticsWindowsSec,omitempty"`
+	DownLossCount            uint64                   `protobuf:"varint,17,opt,name=DownLossCount,proto3" json:"DownLossCount,omitempty"`
+	UpSuccessCount           uint64                   `protobuf:"varint,18,opt,name=UpSuccessCount,proto3" json:"UpSuccessCount,omitempty"`
+	DegradedLossPercent      float64                  `protobuf:"fixed64,19,opt,name=DegradedLossPercent,proto3" json:"DegradedLossPercent,omitempty"`
+	HoldDownMillisec         uint64                   `protobuf:"varint,20,opt,name=HoldDownMillisec,proto3" json:"HoldDownMillisec,omitempty"`
+	Paused                   bool                     `protobuf:"varint,21,opt,name=Paused,proto3" json:"Paused,omitempty"`
+	PausedUnixNanosec        uint64                   `protobuf:"varint,22,opt,name=PausedUnixNanosec,proto3" json:"PausedUnixNanosec,omitempty"`
+	TotalPausedNanosec       uint64                   `protobuf:"varint,23,opt,name=TotalPausedNanosec,proto3" json:"TotalPausedNanosec,omitempty"`
+	Handle                   string                   `protobuf:"bytes,24,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	IcmpID                   uint32                   `protobuf:"varint,25,opt,name=IcmpID,proto3" json:"IcmpID,omitempty"`
+	Count                    uint64                   `protobuf:"varint,26,opt,name=Count,proto3" json:"Count,omitempty"`
+	ReplayBufferSize         uint64                   `protobuf:"varint,27,opt,name=ReplayBufferSize,proto3" json:"ReplayBufferSize,omitempty"`
+	ResultsDroppedCount      uint64                   `protobuf:"varint,28,opt,name=ResultsDroppedCount,proto3" json:"ResultsDroppedCount,omitempty"`
+	StatisticsDroppedCount   uint64                   `protobuf:"varint,29,opt,name=StatisticsDroppedCount,proto3" json:"StatisticsDroppedCount,omitempty"`
+	HopTablesDroppedCount    uint64                   `protobuf:"varint,30,opt,name=HopTablesDroppedCount,proto3" json:"HopTablesDroppedCount,omitempty"`
+	TargetStatesDroppedCount uint64                   `protobuf:"varint,31,opt,name=TargetStatesDroppedCount,proto3" json:"TargetStatesDroppedCount,omitempty"`
+	PingerDroppedCount       uint64                   `protobuf:"varint,32,opt,name=PingerDroppedCount,proto3" json:"PingerDroppedCount,omitempty"`
+	ResponsesDroppedCount    uint64                   `protobuf:"varint,33,opt,name=ResponsesDroppedCount,proto3" json:"ResponsesDroppedCount,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                 `json:"-"`
+	XXX_unrecognized         []byte                   `json:"-"`
+	XXX_sizecache            int32                    `json:"-"`
+}
+
+func (m *PingerInfo) Reset()         { *m = PingerInfo{} }
+func (m *PingerInfo) String() string { return proto.CompactTextString(m) }
+func (*PingerInfo) ProtoMessage()    {}
+func (*PingerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{7}
+}
+
+func (m *PingerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerInfo.Unmarshal(m, b)
+}
+func (m *PingerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerInfo.Marshal(b, m, deterministic)
+}
+func (m *PingerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerInfo.Merge(m, src)
+}
+func (m *PingerInfo) XXX_Size() int {
+	return xxx_messageInfo_PingerInfo.Size(m)
+}
+func (m *PingerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerInfo proto.InternalMessageInfo
+
+func (m *PingerInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PingerInfo) GetTargets() []*PingerInfo_IcmpTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *PingerInfo) GetIntervalMillisec() uint64 {
+	if m != nil {
+		return m.IntervalMillisec
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetTimeoutMillisec() uint64 {
+	if m != nil {
+		return m.TimeoutMillisec
 	}
 	return 0
 }
@@ -524,182 +1656,2397 @@ func (m *PingerInfo) GetStatisticsCountsNum() uint64 {
 	return 0
 }
 
-func (m *PingerInfo) GetStatisticsIntervalSec() uint64 {
+func (m *PingerInfo) GetStatisticsIntervalSec() uint64 {
+	if m != nil {
+		return m.StatisticsIntervalSec
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetStartUnixNanosec() uint64 {
+	if m != nil {
+		return m.StartUnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetExpireUnixNanosec() uint64 {
+	if m != nil {
+		return m.ExpireUnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetMode() PingerMode {
+	if m != nil {
+		return m.Mode
+	}
+	return PingerMode_PingerModePing
+}
+
+func (m *PingerInfo) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetTTL() uint64 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetTOS() uint64 {
+	if m != nil {
+		return m.TOS
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetPayloadPattern() []byte {
+	if m != nil {
+		return m.PayloadPattern
+	}
+	return nil
+}
+
+func (m *PingerInfo) GetDontFragment() bool {
+	if m != nil {
+		return m.DontFragment
+	}
+	return false
+}
+
+func (m *PingerInfo) GetStatisticsWindowsSec() []uint64 {
+	if m != nil {
+		return m.StatisticsWindowsSec
+	}
+	return nil
+}
+
+func (m *PingerInfo) GetDownLossCount() uint64 {
+	if m != nil {
+		return m.DownLossCount
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetUpSuccessCount() uint64 {
+	if m != nil {
+		return m.UpSuccessCount
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetDegradedLossPercent() float64 {
+	if m != nil {
+		return m.DegradedLossPercent
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetHoldDownMillisec() uint64 {
+	if m != nil {
+		return m.HoldDownMillisec
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *PingerInfo) GetPausedUnixNanosec() uint64 {
+	if m != nil {
+		return m.PausedUnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetTotalPausedNanosec() uint64 {
+	if m != nil {
+		return m.TotalPausedNanosec
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *PingerInfo) GetIcmpID() uint32 {
+	if m != nil {
+		return m.IcmpID
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetReplayBufferSize() uint64 {
+	if m != nil {
+		return m.ReplayBufferSize
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetResultsDroppedCount() uint64 {
+	if m != nil {
+		return m.ResultsDroppedCount
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetStatisticsDroppedCount() uint64 {
+	if m != nil {
+		return m.StatisticsDroppedCount
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetHopTablesDroppedCount() uint64 {
+	if m != nil {
+		return m.HopTablesDroppedCount
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetTargetStatesDroppedCount() uint64 {
+	if m != nil {
+		return m.TargetStatesDroppedCount
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetPingerDroppedCount() uint64 {
+	if m != nil {
+		return m.PingerDroppedCount
+	}
+	return 0
+}
+
+func (m *PingerInfo) GetResponsesDroppedCount() uint64 {
+	if m != nil {
+		return m.ResponsesDroppedCount
+	}
+	return 0
+}
+
+type PingerInfo_IcmpTarget struct {
+	TargetIP             string    `protobuf:"bytes,1,opt,name=TargetIP,proto3" json:"TargetIP,omitempty"`
+	TargetBinIP          string    `protobuf:"bytes,4,opt,name=TargetBinIP,proto3" json:"TargetBinIP,omitempty"`
+	Comment              string    `protobuf:"bytes,2,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	TargetID             uint32    `protobuf:"fixed32,3,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	TargetID128          []byte    `protobuf:"bytes,5,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	ProbeType            ProbeType `protobuf:"varint,6,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                 uint32    `protobuf:"varint,7,opt,name=Port,proto3" json:"Port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PingerInfo_IcmpTarget) Reset()         { *m = PingerInfo_IcmpTarget{} }
+func (m *PingerInfo_IcmpTarget) String() string { return proto.CompactTextString(m) }
+func (*PingerInfo_IcmpTarget) ProtoMessage()    {}
+func (*PingerInfo_IcmpTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{7, 0}
+}
+
+func (m *PingerInfo_IcmpTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerInfo_IcmpTarget.Unmarshal(m, b)
+}
+func (m *PingerInfo_IcmpTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerInfo_IcmpTarget.Marshal(b, m, deterministic)
+}
+func (m *PingerInfo_IcmpTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerInfo_IcmpTarget.Merge(m, src)
+}
+func (m *PingerInfo_IcmpTarget) XXX_Size() int {
+	return xxx_messageInfo_PingerInfo_IcmpTarget.Size(m)
+}
+func (m *PingerInfo_IcmpTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerInfo_IcmpTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerInfo_IcmpTarget proto.InternalMessageInfo
+
+func (m *PingerInfo_IcmpTarget) GetTargetIP() string {
+	if m != nil {
+		return m.TargetIP
+	}
+	return ""
+}
+
+func (m *PingerInfo_IcmpTarget) GetTargetBinIP() string {
+	if m != nil {
+		return m.TargetBinIP
+	}
+	return ""
+}
+
+func (m *PingerInfo_IcmpTarget) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *PingerInfo_IcmpTarget) GetTargetID() uint32 {
+	if m != nil {
+		return m.TargetID
+	}
+	return 0
+}
+
+func (m *PingerInfo_IcmpTarget) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
+}
+
+func (m *PingerInfo_IcmpTarget) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
+}
+
+func (m *PingerInfo_IcmpTarget) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+type PingerEvent struct {
+	Type                 PingerEvent_EventType `protobuf:"varint,1,opt,name=Type,proto3,enum=uPinger.PingerEvent_EventType" json:"Type,omitempty"`
+	Handle               string                `protobuf:"bytes,2,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	UnixNanosec          uint64                `protobuf:"varint,3,opt,name=UnixNanosec,proto3" json:"UnixNanosec,omitempty"`
+	Description          string                `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
+	Summary              *PingerSummary        `protobuf:"bytes,5,opt,name=Summary,proto3" json:"Summary,omitempty"`
+	Info                 *PingerInfo           `protobuf:"bytes,6,opt,name=Info,proto3" json:"Info,omitempty"`
+	DroppedCount         uint64                `protobuf:"varint,7,opt,name=DroppedCount,proto3" json:"DroppedCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PingerEvent) Reset()         { *m = PingerEvent{} }
+func (m *PingerEvent) String() string { return proto.CompactTextString(m) }
+func (*PingerEvent) ProtoMessage()    {}
+func (*PingerEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{8}
+}
+
+func (m *PingerEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerEvent.Unmarshal(m, b)
+}
+func (m *PingerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerEvent.Marshal(b, m, deterministic)
+}
+func (m *PingerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerEvent.Merge(m, src)
+}
+func (m *PingerEvent) XXX_Size() int {
+	return xxx_messageInfo_PingerEvent.Size(m)
+}
+func (m *PingerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerEvent proto.InternalMessageInfo
+
+func (m *PingerEvent) GetType() PingerEvent_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return PingerEvent_PingerEventTypeUnknown
+}
+
+func (m *PingerEvent) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *PingerEvent) GetUnixNanosec() uint64 {
+	if m != nil {
+		return m.UnixNanosec
+	}
+	return 0
+}
+
+func (m *PingerEvent) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PingerEvent) GetSummary() *PingerSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *PingerEvent) GetInfo() *PingerInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *PingerEvent) GetDroppedCount() uint64 {
+	if m != nil {
+		return m.DroppedCount
+	}
+	return 0
+}
+
+type PingerWatchMessage struct {
+	Type                 PingerWatchMessage_MessageType `protobuf:"varint,1,opt,name=Type,proto3,enum=uPinger.PingerWatchMessage_MessageType" json:"Type,omitempty"`
+	Pingers              *PingerList                    `protobuf:"bytes,2,opt,name=Pingers,proto3" json:"Pingers,omitempty"`
+	Event                *PingerEvent                   `protobuf:"bytes,3,opt,name=Event,proto3" json:"Event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *PingerWatchMessage) Reset()         { *m = PingerWatchMessage{} }
+func (m *PingerWatchMessage) String() string { return proto.CompactTextString(m) }
+func (*PingerWatchMessage) ProtoMessage()    {}
+func (*PingerWatchMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{9}
+}
+
+func (m *PingerWatchMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingerWatchMessage.Unmarshal(m, b)
+}
+func (m *PingerWatchMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingerWatchMessage.Marshal(b, m, deterministic)
+}
+func (m *PingerWatchMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingerWatchMessage.Merge(m, src)
+}
+func (m *PingerWatchMessage) XXX_Size() int {
+	return xxx_messageInfo_PingerWatchMessage.Size(m)
+}
+func (m *PingerWatchMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingerWatchMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingerWatchMessage proto.InternalMessageInfo
+
+func (m *PingerWatchMessage) GetType() PingerWatchMessage_MessageType {
+	if m != nil {
+		return m.Type
+	}
+	return PingerWatchMessage_PingerWatchMessageTypeUnknown
+}
+
+func (m *PingerWatchMessage) GetPingers() *PingerList {
+	if m != nil {
+		return m.Pingers
+	}
+	return nil
+}
+
+func (m *PingerWatchMessage) GetEvent() *PingerEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	Action               SubscribeRequest_ActionType `protobuf:"varint,1,opt,name=Action,proto3,enum=uPinger.SubscribeRequest_ActionType" json:"Action,omitempty"`
+	Stream               *StreamRequest              `protobuf:"bytes,2,opt,name=Stream,proto3" json:"Stream,omitempty"`
+	Results              bool                        `protobuf:"varint,3,opt,name=Results,proto3" json:"Results,omitempty"`
+	Statistics           bool                        `protobuf:"varint,4,opt,name=Statistics,proto3" json:"Statistics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{10}
+}
+
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetAction() SubscribeRequest_ActionType {
+	if m != nil {
+		return m.Action
+	}
+	return SubscribeRequest_SubscribeActionAdd
+}
+
+func (m *SubscribeRequest) GetStream() *StreamRequest {
+	if m != nil {
+		return m.Stream
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetResults() bool {
+	if m != nil {
+		return m.Results
+	}
+	return false
+}
+
+func (m *SubscribeRequest) GetStatistics() bool {
+	if m != nil {
+		return m.Statistics
+	}
+	return false
+}
+
+type SubscribeMessage struct {
+	Type                 SubscribeMessage_MessageType `protobuf:"varint,1,opt,name=Type,proto3,enum=uPinger.SubscribeMessage_MessageType" json:"Type,omitempty"`
+	Handle               string                       `protobuf:"bytes,2,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	Result               *IcmpResult                  `protobuf:"bytes,3,opt,name=Result,proto3" json:"Result,omitempty"`
+	Statistics           *Statistics                  `protobuf:"bytes,4,opt,name=Statistics,proto3" json:"Statistics,omitempty"`
+	Event                *PingerEvent                 `protobuf:"bytes,5,opt,name=Event,proto3" json:"Event,omitempty"`
+	Error                string                       `protobuf:"bytes,6,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SubscribeMessage) Reset()         { *m = SubscribeMessage{} }
+func (m *SubscribeMessage) String() string { return proto.CompactTextString(m) }
+func (*SubscribeMessage) ProtoMessage()    {}
+func (*SubscribeMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{11}
+}
+
+func (m *SubscribeMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeMessage.Unmarshal(m, b)
+}
+func (m *SubscribeMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeMessage.Marshal(b, m, deterministic)
+}
+func (m *SubscribeMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeMessage.Merge(m, src)
+}
+func (m *SubscribeMessage) XXX_Size() int {
+	return xxx_messageInfo_SubscribeMessage.Size(m)
+}
+func (m *SubscribeMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeMessage proto.InternalMessageInfo
+
+func (m *SubscribeMessage) GetType() SubscribeMessage_MessageType {
+	if m != nil {
+		return m.Type
+	}
+	return SubscribeMessage_SubscribeMessageTypeUnknown
+}
+
+func (m *SubscribeMessage) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *SubscribeMessage) GetResult() *IcmpResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SubscribeMessage) GetStatistics() *Statistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
+}
+
+func (m *SubscribeMessage) GetEvent() *PingerEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SubscribeMessage) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type BatchStreamRequest struct {
+	Stream                *StreamRequest `protobuf:"bytes,1,opt,name=Stream,proto3" json:"Stream,omitempty"`
+	FlushIntervalMillisec uint64         `protobuf:"varint,2,opt,name=FlushIntervalMillisec,proto3" json:"FlushIntervalMillisec,omitempty"`
+	MaxBatchSize          uint64         `protobuf:"varint,3,opt,name=MaxBatchSize,proto3" json:"MaxBatchSize,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}       `json:"-"`
+	XXX_unrecognized      []byte         `json:"-"`
+	XXX_sizecache         int32          `json:"-"`
+}
+
+func (m *BatchStreamRequest) Reset()         { *m = BatchStreamRequest{} }
+func (m *BatchStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchStreamRequest) ProtoMessage()    {}
+func (*BatchStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{12}
+}
+
+func (m *BatchStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchStreamRequest.Unmarshal(m, b)
+}
+func (m *BatchStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchStreamRequest.Merge(m, src)
+}
+func (m *BatchStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchStreamRequest.Size(m)
+}
+func (m *BatchStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchStreamRequest proto.InternalMessageInfo
+
+func (m *BatchStreamRequest) GetStream() *StreamRequest {
+	if m != nil {
+		return m.Stream
+	}
+	return nil
+}
+
+func (m *BatchStreamRequest) GetFlushIntervalMillisec() uint64 {
+	if m != nil {
+		return m.FlushIntervalMillisec
+	}
+	return 0
+}
+
+func (m *BatchStreamRequest) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+type IcmpResultBatch struct {
+	BaseUnixNanosec      int64                     `protobuf:"varint,1,opt,name=BaseUnixNanosec,proto3" json:"BaseUnixNanosec,omitempty"`
+	Targets              []*IcmpResultBatch_Target `protobuf:"bytes,2,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Results              []*IcmpResultBatch_Result `protobuf:"bytes,3,rep,name=Results,proto3" json:"Results,omitempty"`
+	DroppedCount         uint64                    `protobuf:"varint,4,opt,name=DroppedCount,proto3" json:"DroppedCount,omitempty"`
+	Summary              *PingerSummary            `protobuf:"bytes,5,opt,name=Summary,proto3" json:"Summary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *IcmpResultBatch) Reset()         { *m = IcmpResultBatch{} }
+func (m *IcmpResultBatch) String() string { return proto.CompactTextString(m) }
+func (*IcmpResultBatch) ProtoMessage()    {}
+func (*IcmpResultBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{13}
+}
+
+func (m *IcmpResultBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IcmpResultBatch.Unmarshal(m, b)
+}
+func (m *IcmpResultBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IcmpResultBatch.Marshal(b, m, deterministic)
+}
+func (m *IcmpResultBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcmpResultBatch.Merge(m, src)
+}
+func (m *IcmpResultBatch) XXX_Size() int {
+	return xxx_messageInfo_IcmpResultBatch.Size(m)
+}
+func (m *IcmpResultBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcmpResultBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcmpResultBatch proto.InternalMessageInfo
+
+func (m *IcmpResultBatch) GetBaseUnixNanosec() int64 {
+	if m != nil {
+		return m.BaseUnixNanosec
+	}
+	return 0
+}
+
+func (m *IcmpResultBatch) GetTargets() []*IcmpResultBatch_Target {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *IcmpResultBatch) GetResults() []*IcmpResultBatch_Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *IcmpResultBatch) GetDroppedCount() uint64 {
+	if m != nil {
+		return m.DroppedCount
+	}
+	return 0
+}
+
+func (m *IcmpResultBatch) GetSummary() *PingerSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+type IcmpResultBatch_Target struct {
+	TargetID             uint32    `protobuf:"fixed32,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	TargetID128          []byte    `protobuf:"bytes,2,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	ProbeType            ProbeType `protobuf:"varint,3,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                 uint32    `protobuf:"varint,4,opt,name=Port,proto3" json:"Port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *IcmpResultBatch_Target) Reset()         { *m = IcmpResultBatch_Target{} }
+func (m *IcmpResultBatch_Target) String() string { return proto.CompactTextString(m) }
+func (*IcmpResultBatch_Target) ProtoMessage()    {}
+func (*IcmpResultBatch_Target) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{13, 0}
+}
+
+func (m *IcmpResultBatch_Target) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IcmpResultBatch_Target.Unmarshal(m, b)
+}
+func (m *IcmpResultBatch_Target) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IcmpResultBatch_Target.Marshal(b, m, deterministic)
+}
+func (m *IcmpResultBatch_Target) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcmpResultBatch_Target.Merge(m, src)
+}
+func (m *IcmpResultBatch_Target) XXX_Size() int {
+	return xxx_messageInfo_IcmpResultBatch_Target.Size(m)
+}
+func (m *IcmpResultBatch_Target) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcmpResultBatch_Target.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcmpResultBatch_Target proto.InternalMessageInfo
+
+func (m *IcmpResultBatch_Target) GetTargetID() uint32 {
+	if m != nil {
+		return m.TargetID
+	}
+	return 0
+}
+
+func (m *IcmpResultBatch_Target) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
+}
+
+func (m *IcmpResultBatch_Target) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
+}
+
+func (m *IcmpResultBatch_Target) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+type IcmpResultBatch_Result struct {
+	Type                    IcmpResult_ResultType `protobuf:"varint,1,opt,name=Type,proto3,enum=uPinger.IcmpResult_ResultType" json:"Type,omitempty"`
+	TargetIndex             uint32                `protobuf:"varint,2,opt,name=TargetIndex,proto3" json:"TargetIndex,omitempty"`
+	Sequence                int64                 `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	SendTimeDeltaNanosec    int64                 `protobuf:"zigzag64,4,opt,name=SendTimeDeltaNanosec,proto3" json:"SendTimeDeltaNanosec,omitempty"`
+	ReceiveTimeDeltaNanosec int64                 `protobuf:"zigzag64,5,opt,name=ReceiveTimeDeltaNanosec,proto3" json:"ReceiveTimeDeltaNanosec,omitempty"`
+	BinPeerIP128            []byte                `protobuf:"bytes,6,opt,name=BinPeerIP128,proto3" json:"BinPeerIP128,omitempty"`
+	TTL                     uint32                `protobuf:"varint,7,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	StreamSequence          uint64                `protobuf:"varint,8,opt,name=StreamSequence,proto3" json:"StreamSequence,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}              `json:"-"`
+	XXX_unrecognized        []byte                `json:"-"`
+	XXX_sizecache           int32                 `json:"-"`
+}
+
+func (m *IcmpResultBatch_Result) Reset()         { *m = IcmpResultBatch_Result{} }
+func (m *IcmpResultBatch_Result) String() string { return proto.CompactTextString(m) }
+func (*IcmpResultBatch_Result) ProtoMessage()    {}
+func (*IcmpResultBatch_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{13, 1}
+}
+
+func (m *IcmpResultBatch_Result) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IcmpResultBatch_Result.Unmarshal(m, b)
+}
+func (m *IcmpResultBatch_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IcmpResultBatch_Result.Marshal(b, m, deterministic)
+}
+func (m *IcmpResultBatch_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcmpResultBatch_Result.Merge(m, src)
+}
+func (m *IcmpResultBatch_Result) XXX_Size() int {
+	return xxx_messageInfo_IcmpResultBatch_Result.Size(m)
+}
+func (m *IcmpResultBatch_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcmpResultBatch_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcmpResultBatch_Result proto.InternalMessageInfo
+
+func (m *IcmpResultBatch_Result) GetType() IcmpResult_ResultType {
+	if m != nil {
+		return m.Type
+	}
+	return IcmpResult_IcmpResultTypeUnknown
+}
+
+func (m *IcmpResultBatch_Result) GetTargetIndex() uint32 {
+	if m != nil {
+		return m.TargetIndex
+	}
+	return 0
+}
+
+func (m *IcmpResultBatch_Result) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IcmpResultBatch_Result) GetSendTimeDeltaNanosec() int64 {
+	if m != nil {
+		return m.SendTimeDeltaNanosec
+	}
+	return 0
+}
+
+func (m *IcmpResultBatch_Result) GetReceiveTimeDeltaNanosec() int64 {
+	if m != nil {
+		return m.ReceiveTimeDeltaNanosec
+	}
+	return 0
+}
+
+func (m *IcmpResultBatch_Result) GetBinPeerIP128() []byte {
+	if m != nil {
+		return m.BinPeerIP128
+	}
+	return nil
+}
+
+func (m *IcmpResultBatch_Result) GetTTL() uint32 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *IcmpResultBatch_Result) GetStreamSequence() uint64 {
+	if m != nil {
+		return m.StreamSequence
+	}
+	return 0
+}
+
+type IcmpResult struct {
+	Type                   IcmpResult_ResultType `protobuf:"varint,1,opt,name=type,proto3,enum=uPinger.IcmpResult_ResultType" json:"type,omitempty"`
+	TargetID               uint32                `protobuf:"fixed32,2,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	BinPeerIP              uint32                `protobuf:"fixed32,3,opt,name=BinPeerIP,proto3" json:"BinPeerIP,omitempty"`
+	Sequence               int64                 `protobuf:"varint,4,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	SendTimeUnixNanosec    int64                 `protobuf:"varint,5,opt,name=SendTimeUnixNanosec,proto3" json:"SendTimeUnixNanosec,omitempty"`
+	ReceiveTimeUnixNanosec int64                 `protobuf:"varint,6,opt,name=ReceiveTimeUnixNanosec,proto3" json:"ReceiveTimeUnixNanosec,omitempty"`
+	TargetID128            []byte                `protobuf:"bytes,7,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	BinPeerIP128           []byte                `protobuf:"bytes,8,opt,name=BinPeerIP128,proto3" json:"BinPeerIP128,omitempty"`
+	ProbeType              ProbeType             `protobuf:"varint,9,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                   uint32                `protobuf:"varint,10,opt,name=Port,proto3" json:"Port,omitempty"`
+	TTL                    uint32                `protobuf:"varint,11,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	Summary                *PingerSummary        `protobuf:"bytes,12,opt,name=Summary,proto3" json:"Summary,omitempty"`
+	StreamSequence         uint64                `protobuf:"varint,13,opt,name=StreamSequence,proto3" json:"StreamSequence,omitempty"`
+	DroppedCount           uint64                `protobuf:"varint,14,opt,name=DroppedCount,proto3" json:"DroppedCount,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
+	XXX_unrecognized       []byte                `json:"-"`
+	XXX_sizecache          int32                 `json:"-"`
+}
+
+func (m *IcmpResult) Reset()         { *m = IcmpResult{} }
+func (m *IcmpResult) String() string { return proto.CompactTextString(m) }
+func (*IcmpResult) ProtoMessage()    {}
+func (*IcmpResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{14}
+}
+
+func (m *IcmpResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IcmpResult.Unmarshal(m, b)
+}
+func (m *IcmpResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IcmpResult.Marshal(b, m, deterministic)
+}
+func (m *IcmpResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcmpResult.Merge(m, src)
+}
+func (m *IcmpResult) XXX_Size() int {
+	return xxx_messageInfo_IcmpResult.Size(m)
+}
+func (m *IcmpResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcmpResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcmpResult proto.InternalMessageInfo
+
+func (m *IcmpResult) GetType() IcmpResult_ResultType {
+	if m != nil {
+		return m.Type
+	}
+	return IcmpResult_IcmpResultTypeUnknown
+}
+
+func (m *IcmpResult) GetTargetID() uint32 {
+	if m != nil {
+		return m.TargetID
+	}
+	return 0
+}
+
+func (m *IcmpResult) GetBinPeerIP() uint32 {
+	if m != nil {
+		return m.BinPeerIP
+	}
+	return 0
+}
+
+func (m *IcmpResult) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IcmpResult) GetSendTimeUnixNanosec() int64 {
+	if m != nil {
+		return m.SendTimeUnixNanosec
+	}
+	return 0
+}
+
+func (m *IcmpResult) GetReceiveTimeUnixNanosec() int64 {
+	if m != nil {
+		return m.ReceiveTimeUnixNanosec
+	}
+	return 0
+}
+
+func (m *IcmpResult) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
+}
+
+func (m *IcmpResult) GetBinPeerIP128() []byte {
+	if m != nil {
+		return m.BinPeerIP128
+	}
+	return nil
+}
+
+func (m *IcmpResult) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
+}
+
+func (m *IcmpResult) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *IcmpResult) GetTTL() uint32 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *IcmpResult) GetSummary() *PingerSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *IcmpResult) GetStreamSequence() uint64 {
+	if m != nil {
+		return m.StreamSequence
+	}
+	return 0
+}
+
+func (m *IcmpResult) GetDroppedCount() uint64 {
+	if m != nil {
+		return m.DroppedCount
+	}
+	return 0
+}
+
+type HopTable struct {
+	Type                 HopTable_HopTableType `protobuf:"varint,1,opt,name=Type,proto3,enum=uPinger.HopTable_HopTableType" json:"Type,omitempty"`
+	Targets              []*HopTable_Target    `protobuf:"bytes,2,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Change               *HopTable_PathChange  `protobuf:"bytes,3,opt,name=Change,proto3" json:"Change,omitempty"`
+	Summary              *PingerSummary        `protobuf:"bytes,4,opt,name=Summary,proto3" json:"Summary,omitempty"`
+	DroppedCount         uint64                `protobuf:"varint,5,opt,name=DroppedCount,proto3" json:"DroppedCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HopTable) Reset()         { *m = HopTable{} }
+func (m *HopTable) String() string { return proto.CompactTextString(m) }
+func (*HopTable) ProtoMessage()    {}
+func (*HopTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{15}
+}
+
+func (m *HopTable) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopTable.Unmarshal(m, b)
+}
+func (m *HopTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopTable.Marshal(b, m, deterministic)
+}
+func (m *HopTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopTable.Merge(m, src)
+}
+func (m *HopTable) XXX_Size() int {
+	return xxx_messageInfo_HopTable.Size(m)
+}
+func (m *HopTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopTable proto.InternalMessageInfo
+
+func (m *HopTable) GetType() HopTable_HopTableType {
+	if m != nil {
+		return m.Type
+	}
+	return HopTable_HopTableTypeTable
+}
+
+func (m *HopTable) GetTargets() []*HopTable_Target {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *HopTable) GetChange() *HopTable_PathChange {
+	if m != nil {
+		return m.Change
+	}
+	return nil
+}
+
+func (m *HopTable) GetSummary() *PingerSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *HopTable) GetDroppedCount() uint64 {
+	if m != nil {
+		return m.DroppedCount
+	}
+	return 0
+}
+
+type HopTable_Hop struct {
+	TTL                  uint32                `protobuf:"varint,1,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	BinPeerIP            uint32                `protobuf:"fixed32,2,opt,name=BinPeerIP,proto3" json:"BinPeerIP,omitempty"`
+	BinPeerIP128         []byte                `protobuf:"bytes,3,opt,name=BinPeerIP128,proto3" json:"BinPeerIP128,omitempty"`
+	SentCount            int64                 `protobuf:"varint,4,opt,name=SentCount,proto3" json:"SentCount,omitempty"`
+	ReceivedCount        int64                 `protobuf:"varint,5,opt,name=ReceivedCount,proto3" json:"ReceivedCount,omitempty"`
+	LastResultType       IcmpResult_ResultType `protobuf:"varint,6,opt,name=LastResultType,proto3,enum=uPinger.IcmpResult_ResultType" json:"LastResultType,omitempty"`
+	LastRttNanosec       int64                 `protobuf:"varint,7,opt,name=LastRttNanosec,proto3" json:"LastRttNanosec,omitempty"`
+	MinRttNanosec        int64                 `protobuf:"varint,8,opt,name=MinRttNanosec,proto3" json:"MinRttNanosec,omitempty"`
+	AvgRttNanosec        int64                 `protobuf:"varint,9,opt,name=AvgRttNanosec,proto3" json:"AvgRttNanosec,omitempty"`
+	MaxRttNanosec        int64                 `protobuf:"varint,10,opt,name=MaxRttNanosec,proto3" json:"MaxRttNanosec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HopTable_Hop) Reset()         { *m = HopTable_Hop{} }
+func (m *HopTable_Hop) String() string { return proto.CompactTextString(m) }
+func (*HopTable_Hop) ProtoMessage()    {}
+func (*HopTable_Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{15, 0}
+}
+
+func (m *HopTable_Hop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopTable_Hop.Unmarshal(m, b)
+}
+func (m *HopTable_Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopTable_Hop.Marshal(b, m, deterministic)
+}
+func (m *HopTable_Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopTable_Hop.Merge(m, src)
+}
+func (m *HopTable_Hop) XXX_Size() int {
+	return xxx_messageInfo_HopTable_Hop.Size(m)
+}
+func (m *HopTable_Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopTable_Hop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopTable_Hop proto.InternalMessageInfo
+
+func (m *HopTable_Hop) GetTTL() uint32 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *HopTable_Hop) GetBinPeerIP() uint32 {
+	if m != nil {
+		return m.BinPeerIP
+	}
+	return 0
+}
+
+func (m *HopTable_Hop) GetBinPeerIP128() []byte {
+	if m != nil {
+		return m.BinPeerIP128
+	}
+	return nil
+}
+
+func (m *HopTable_Hop) GetSentCount() int64 {
+	if m != nil {
+		return m.SentCount
+	}
+	return 0
+}
+
+func (m *HopTable_Hop) GetReceivedCount() int64 {
+	if m != nil {
+		return m.ReceivedCount
+	}
+	return 0
+}
+
+func (m *HopTable_Hop) GetLastResultType() IcmpResult_ResultType {
+	if m != nil {
+		return m.LastResultType
+	}
+	return IcmpResult_IcmpResultTypeUnknown
+}
+
+func (m *HopTable_Hop) GetLastRttNanosec() int64 {
+	if m != nil {
+		return m.LastRttNanosec
+	}
+	return 0
+}
+
+func (m *HopTable_Hop) GetMinRttNanosec() int64 {
+	if m != nil {
+		return m.MinRttNanosec
+	}
+	return 0
+}
+
+func (m *HopTable_Hop) GetAvgRttNanosec() int64 {
+	if m != nil {
+		return m.AvgRttNanosec
+	}
+	return 0
+}
+
+func (m *HopTable_Hop) GetMaxRttNanosec() int64 {
+	if m != nil {
+		return m.MaxRttNanosec
+	}
+	return 0
+}
+
+type HopTable_Target struct {
+	TargetID             uint32          `protobuf:"fixed32,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	TargetID128          []byte          `protobuf:"bytes,2,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	ProbeType            ProbeType       `protobuf:"varint,3,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                 uint32          `protobuf:"varint,4,opt,name=Port,proto3" json:"Port,omitempty"`
+	Hops                 []*HopTable_Hop `protobuf:"bytes,5,rep,name=Hops,proto3" json:"Hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HopTable_Target) Reset()         { *m = HopTable_Target{} }
+func (m *HopTable_Target) String() string { return proto.CompactTextString(m) }
+func (*HopTable_Target) ProtoMessage()    {}
+func (*HopTable_Target) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{15, 1}
+}
+
+func (m *HopTable_Target) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopTable_Target.Unmarshal(m, b)
+}
+func (m *HopTable_Target) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopTable_Target.Marshal(b, m, deterministic)
+}
+func (m *HopTable_Target) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopTable_Target.Merge(m, src)
+}
+func (m *HopTable_Target) XXX_Size() int {
+	return xxx_messageInfo_HopTable_Target.Size(m)
+}
+func (m *HopTable_Target) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopTable_Target.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopTable_Target proto.InternalMessageInfo
+
+func (m *HopTable_Target) GetTargetID() uint32 {
+	if m != nil {
+		return m.TargetID
+	}
+	return 0
+}
+
+func (m *HopTable_Target) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
+}
+
+func (m *HopTable_Target) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
+}
+
+func (m *HopTable_Target) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *HopTable_Target) GetHops() []*HopTable_Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+type HopTable_PathChange struct {
+	TargetID              uint32    `protobuf:"fixed32,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	TargetID128           []byte    `protobuf:"bytes,2,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	ProbeType             ProbeType `protobuf:"varint,3,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                  uint32    `protobuf:"varint,4,opt,name=Port,proto3" json:"Port,omitempty"`
+	TTL                   uint32    `protobuf:"varint,5,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	OldBinPeerIP          uint32    `protobuf:"fixed32,6,opt,name=OldBinPeerIP,proto3" json:"OldBinPeerIP,omitempty"`
+	OldBinPeerIP128       []byte    `protobuf:"bytes,7,opt,name=OldBinPeerIP128,proto3" json:"OldBinPeerIP128,omitempty"`
+	NewBinPeerIP          uint32    `protobuf:"fixed32,8,opt,name=NewBinPeerIP,proto3" json:"NewBinPeerIP,omitempty"`
+	NewBinPeerIP128       []byte    `protobuf:"bytes,9,opt,name=NewBinPeerIP128,proto3" json:"NewBinPeerIP128,omitempty"`
+	ChangeTimeUnixNanosec int64     `protobuf:"varint,10,opt,name=ChangeTimeUnixNanosec,proto3" json:"ChangeTimeUnixNanosec,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}  `json:"-"`
+	XXX_unrecognized      []byte    `json:"-"`
+	XXX_sizecache         int32     `json:"-"`
+}
+
+func (m *HopTable_PathChange) Reset()         { *m = HopTable_PathChange{} }
+func (m *HopTable_PathChange) String() string { return proto.CompactTextString(m) }
+func (*HopTable_PathChange) ProtoMessage()    {}
+func (*HopTable_PathChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{15, 2}
+}
+
+func (m *HopTable_PathChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopTable_PathChange.Unmarshal(m, b)
+}
+func (m *HopTable_PathChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopTable_PathChange.Marshal(b, m, deterministic)
+}
+func (m *HopTable_PathChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopTable_PathChange.Merge(m, src)
+}
+func (m *HopTable_PathChange) XXX_Size() int {
+	return xxx_messageInfo_HopTable_PathChange.Size(m)
+}
+func (m *HopTable_PathChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopTable_PathChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopTable_PathChange proto.InternalMessageInfo
+
+func (m *HopTable_PathChange) GetTargetID() uint32 {
+	if m != nil {
+		return m.TargetID
+	}
+	return 0
+}
+
+func (m *HopTable_PathChange) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
+}
+
+func (m *HopTable_PathChange) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
+}
+
+func (m *HopTable_PathChange) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *HopTable_PathChange) GetTTL() uint32 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *HopTable_PathChange) GetOldBinPeerIP() uint32 {
+	if m != nil {
+		return m.OldBinPeerIP
+	}
+	return 0
+}
+
+func (m *HopTable_PathChange) GetOldBinPeerIP128() []byte {
+	if m != nil {
+		return m.OldBinPeerIP128
+	}
+	return nil
+}
+
+func (m *HopTable_PathChange) GetNewBinPeerIP() uint32 {
+	if m != nil {
+		return m.NewBinPeerIP
+	}
+	return 0
+}
+
+func (m *HopTable_PathChange) GetNewBinPeerIP128() []byte {
+	if m != nil {
+		return m.NewBinPeerIP128
+	}
+	return nil
+}
+
+func (m *HopTable_PathChange) GetChangeTimeUnixNanosec() int64 {
+	if m != nil {
+		return m.ChangeTimeUnixNanosec
+	}
+	return 0
+}
+
+type PathMTURequest struct {
+	TargetIP             string   `protobuf:"bytes,1,opt,name=TargetIP,proto3" json:"TargetIP,omitempty"`
+	MinPayloadSize       uint64   `protobuf:"varint,2,opt,name=MinPayloadSize,proto3" json:"MinPayloadSize,omitempty"`
+	MaxPayloadSize       uint64   `protobuf:"varint,3,opt,name=MaxPayloadSize,proto3" json:"MaxPayloadSize,omitempty"`
+	TimeoutMillisec      uint64   `protobuf:"varint,4,opt,name=TimeoutMillisec,proto3" json:"TimeoutMillisec,omitempty"`
+	Attempts             uint64   `protobuf:"varint,5,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PathMTURequest) Reset()         { *m = PathMTURequest{} }
+func (m *PathMTURequest) String() string { return proto.CompactTextString(m) }
+func (*PathMTURequest) ProtoMessage()    {}
+func (*PathMTURequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{16}
+}
+
+func (m *PathMTURequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PathMTURequest.Unmarshal(m, b)
+}
+func (m *PathMTURequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PathMTURequest.Marshal(b, m, deterministic)
+}
+func (m *PathMTURequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PathMTURequest.Merge(m, src)
+}
+func (m *PathMTURequest) XXX_Size() int {
+	return xxx_messageInfo_PathMTURequest.Size(m)
+}
+func (m *PathMTURequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PathMTURequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PathMTURequest proto.InternalMessageInfo
+
+func (m *PathMTURequest) GetTargetIP() string {
+	if m != nil {
+		return m.TargetIP
+	}
+	return ""
+}
+
+func (m *PathMTURequest) GetMinPayloadSize() uint64 {
+	if m != nil {
+		return m.MinPayloadSize
+	}
+	return 0
+}
+
+func (m *PathMTURequest) GetMaxPayloadSize() uint64 {
+	if m != nil {
+		return m.MaxPayloadSize
+	}
+	return 0
+}
+
+func (m *PathMTURequest) GetTimeoutMillisec() uint64 {
+	if m != nil {
+		return m.TimeoutMillisec
+	}
+	return 0
+}
+
+func (m *PathMTURequest) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+type PathMTUResult struct {
+	TargetID             uint32                               `protobuf:"fixed32,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	TargetID128          []byte                               `protobuf:"bytes,2,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	TargetBinIP          string                               `protobuf:"bytes,3,opt,name=TargetBinIP,proto3" json:"TargetBinIP,omitempty"`
+	MaxPayloadSize       uint64                               `protobuf:"varint,4,opt,name=MaxPayloadSize,proto3" json:"MaxPayloadSize,omitempty"`
+	PathMTU              uint64                               `protobuf:"varint,5,opt,name=PathMTU,proto3" json:"PathMTU,omitempty"`
+	ProbeCount           uint64                               `protobuf:"varint,6,opt,name=ProbeCount,proto3" json:"ProbeCount,omitempty"`
+	FragmentationNeededs []*PathMTUResult_FragmentationNeeded `protobuf:"bytes,7,rep,name=FragmentationNeededs,proto3" json:"FragmentationNeededs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *PathMTUResult) Reset()         { *m = PathMTUResult{} }
+func (m *PathMTUResult) String() string { return proto.CompactTextString(m) }
+func (*PathMTUResult) ProtoMessage()    {}
+func (*PathMTUResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{17}
+}
+
+func (m *PathMTUResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PathMTUResult.Unmarshal(m, b)
+}
+func (m *PathMTUResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PathMTUResult.Marshal(b, m, deterministic)
+}
+func (m *PathMTUResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PathMTUResult.Merge(m, src)
+}
+func (m *PathMTUResult) XXX_Size() int {
+	return xxx_messageInfo_PathMTUResult.Size(m)
+}
+func (m *PathMTUResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PathMTUResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PathMTUResult proto.InternalMessageInfo
+
+func (m *PathMTUResult) GetTargetID() uint32 {
+	if m != nil {
+		return m.TargetID
+	}
+	return 0
+}
+
+func (m *PathMTUResult) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
+}
+
+func (m *PathMTUResult) GetTargetBinIP() string {
+	if m != nil {
+		return m.TargetBinIP
+	}
+	return ""
+}
+
+func (m *PathMTUResult) GetMaxPayloadSize() uint64 {
+	if m != nil {
+		return m.MaxPayloadSize
+	}
+	return 0
+}
+
+func (m *PathMTUResult) GetPathMTU() uint64 {
+	if m != nil {
+		return m.PathMTU
+	}
+	return 0
+}
+
+func (m *PathMTUResult) GetProbeCount() uint64 {
+	if m != nil {
+		return m.ProbeCount
+	}
+	return 0
+}
+
+func (m *PathMTUResult) GetFragmentationNeededs() []*PathMTUResult_FragmentationNeeded {
+	if m != nil {
+		return m.FragmentationNeededs
+	}
+	return nil
+}
+
+type PathMTUResult_FragmentationNeeded struct {
+	BinPeerIP            uint32   `protobuf:"fixed32,1,opt,name=BinPeerIP,proto3" json:"BinPeerIP,omitempty"`
+	BinPeerIP128         []byte   `protobuf:"bytes,2,opt,name=BinPeerIP128,proto3" json:"BinPeerIP128,omitempty"`
+	NextHopMTU           uint64   `protobuf:"varint,3,opt,name=NextHopMTU,proto3" json:"NextHopMTU,omitempty"`
+	PayloadSize          uint64   `protobuf:"varint,4,opt,name=PayloadSize,proto3" json:"PayloadSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PathMTUResult_FragmentationNeeded) Reset()         { *m = PathMTUResult_FragmentationNeeded{} }
+func (m *PathMTUResult_FragmentationNeeded) String() string { return proto.CompactTextString(m) }
+func (*PathMTUResult_FragmentationNeeded) ProtoMessage()    {}
+func (*PathMTUResult_FragmentationNeeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{17, 0}
+}
+
+func (m *PathMTUResult_FragmentationNeeded) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PathMTUResult_FragmentationNeeded.Unmarshal(m, b)
+}
+func (m *PathMTUResult_FragmentationNeeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PathMTUResult_FragmentationNeeded.Marshal(b, m, deterministic)
+}
+func (m *PathMTUResult_FragmentationNeeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PathMTUResult_FragmentationNeeded.Merge(m, src)
+}
+func (m *PathMTUResult_FragmentationNeeded) XXX_Size() int {
+	return xxx_messageInfo_PathMTUResult_FragmentationNeeded.Size(m)
+}
+func (m *PathMTUResult_FragmentationNeeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_PathMTUResult_FragmentationNeeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PathMTUResult_FragmentationNeeded proto.InternalMessageInfo
+
+func (m *PathMTUResult_FragmentationNeeded) GetBinPeerIP() uint32 {
+	if m != nil {
+		return m.BinPeerIP
+	}
+	return 0
+}
+
+func (m *PathMTUResult_FragmentationNeeded) GetBinPeerIP128() []byte {
+	if m != nil {
+		return m.BinPeerIP128
+	}
+	return nil
+}
+
+func (m *PathMTUResult_FragmentationNeeded) GetNextHopMTU() uint64 {
+	if m != nil {
+		return m.NextHopMTU
+	}
+	return 0
+}
+
+func (m *PathMTUResult_FragmentationNeeded) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+type TargetStateChange struct {
+	TargetID              uint32         `protobuf:"fixed32,1,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	TargetID128           []byte         `protobuf:"bytes,2,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	ProbeType             ProbeType      `protobuf:"varint,3,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                  uint32         `protobuf:"varint,4,opt,name=Port,proto3" json:"Port,omitempty"`
+	OldState              TargetState    `protobuf:"varint,5,opt,name=OldState,proto3,enum=uPinger.TargetState" json:"OldState,omitempty"`
+	NewState              TargetState    `protobuf:"varint,6,opt,name=NewState,proto3,enum=uPinger.TargetState" json:"NewState,omitempty"`
+	ChangeTimeUnixNanosec int64          `protobuf:"varint,7,opt,name=ChangeTimeUnixNanosec,proto3" json:"ChangeTimeUnixNanosec,omitempty"`
+	LastRttNanosec        int64          `protobuf:"varint,8,opt,name=LastRttNanosec,proto3" json:"LastRttNanosec,omitempty"`
+	LossPercent           float64        `protobuf:"fixed64,9,opt,name=LossPercent,proto3" json:"LossPercent,omitempty"`
+	Summary               *PingerSummary `protobuf:"bytes,10,opt,name=Summary,proto3" json:"Summary,omitempty"`
+	DroppedCount          uint64         `protobuf:"varint,11,opt,name=DroppedCount,proto3" json:"DroppedCount,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}       `json:"-"`
+	XXX_unrecognized      []byte         `json:"-"`
+	XXX_sizecache         int32          `json:"-"`
+}
+
+func (m *TargetStateChange) Reset()         { *m = TargetStateChange{} }
+func (m *TargetStateChange) String() string { return proto.CompactTextString(m) }
+func (*TargetStateChange) ProtoMessage()    {}
+func (*TargetStateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{18}
+}
+
+func (m *TargetStateChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TargetStateChange.Unmarshal(m, b)
+}
+func (m *TargetStateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TargetStateChange.Marshal(b, m, deterministic)
+}
+func (m *TargetStateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetStateChange.Merge(m, src)
+}
+func (m *TargetStateChange) XXX_Size() int {
+	return xxx_messageInfo_TargetStateChange.Size(m)
+}
+func (m *TargetStateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetStateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetStateChange proto.InternalMessageInfo
+
+func (m *TargetStateChange) GetTargetID() uint32 {
+	if m != nil {
+		return m.TargetID
+	}
+	return 0
+}
+
+func (m *TargetStateChange) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
+}
+
+func (m *TargetStateChange) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
+}
+
+func (m *TargetStateChange) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *TargetStateChange) GetOldState() TargetState {
+	if m != nil {
+		return m.OldState
+	}
+	return TargetState_TargetStateUnknown
+}
+
+func (m *TargetStateChange) GetNewState() TargetState {
+	if m != nil {
+		return m.NewState
+	}
+	return TargetState_TargetStateUnknown
+}
+
+func (m *TargetStateChange) GetChangeTimeUnixNanosec() int64 {
+	if m != nil {
+		return m.ChangeTimeUnixNanosec
+	}
+	return 0
+}
+
+func (m *TargetStateChange) GetLastRttNanosec() int64 {
+	if m != nil {
+		return m.LastRttNanosec
+	}
+	return 0
+}
+
+func (m *TargetStateChange) GetLossPercent() float64 {
+	if m != nil {
+		return m.LossPercent
+	}
+	return 0
+}
+
+func (m *TargetStateChange) GetSummary() *PingerSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *TargetStateChange) GetDroppedCount() uint64 {
+	if m != nil {
+		return m.DroppedCount
+	}
+	return 0
+}
+
+type TargetsRequest struct {
+	Targets              []*StartRequest_IcmpTarget `protobuf:"bytes,2,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Handle               string                     `protobuf:"bytes,3,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *TargetsRequest) Reset()         { *m = TargetsRequest{} }
+func (m *TargetsRequest) String() string { return proto.CompactTextString(m) }
+func (*TargetsRequest) ProtoMessage()    {}
+func (*TargetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{19}
+}
+
+func (m *TargetsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TargetsRequest.Unmarshal(m, b)
+}
+func (m *TargetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TargetsRequest.Marshal(b, m, deterministic)
+}
+func (m *TargetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetsRequest.Merge(m, src)
+}
+func (m *TargetsRequest) XXX_Size() int {
+	return xxx_messageInfo_TargetsRequest.Size(m)
+}
+func (m *TargetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetsRequest proto.InternalMessageInfo
+
+func (m *TargetsRequest) GetTargets() []*StartRequest_IcmpTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *TargetsRequest) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+type TargetsResponse struct {
+	Results              []*TargetsResponse_TargetResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *TargetsResponse) Reset()         { *m = TargetsResponse{} }
+func (m *TargetsResponse) String() string { return proto.CompactTextString(m) }
+func (*TargetsResponse) ProtoMessage()    {}
+func (*TargetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{20}
+}
+
+func (m *TargetsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TargetsResponse.Unmarshal(m, b)
+}
+func (m *TargetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TargetsResponse.Marshal(b, m, deterministic)
+}
+func (m *TargetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetsResponse.Merge(m, src)
+}
+func (m *TargetsResponse) XXX_Size() int {
+	return xxx_messageInfo_TargetsResponse.Size(m)
+}
+func (m *TargetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetsResponse proto.InternalMessageInfo
+
+func (m *TargetsResponse) GetResults() []*TargetsResponse_TargetResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type TargetsResponse_TargetResult struct {
+	TargetIP             string   `protobuf:"bytes,1,opt,name=TargetIP,proto3" json:"TargetIP,omitempty"`
+	Success              bool     `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TargetsResponse_TargetResult) Reset()         { *m = TargetsResponse_TargetResult{} }
+func (m *TargetsResponse_TargetResult) String() string { return proto.CompactTextString(m) }
+func (*TargetsResponse_TargetResult) ProtoMessage()    {}
+func (*TargetsResponse_TargetResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{20, 0}
+}
+
+func (m *TargetsResponse_TargetResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TargetsResponse_TargetResult.Unmarshal(m, b)
+}
+func (m *TargetsResponse_TargetResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TargetsResponse_TargetResult.Marshal(b, m, deterministic)
+}
+func (m *TargetsResponse_TargetResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetsResponse_TargetResult.Merge(m, src)
+}
+func (m *TargetsResponse_TargetResult) XXX_Size() int {
+	return xxx_messageInfo_TargetsResponse_TargetResult.Size(m)
+}
+func (m *TargetsResponse_TargetResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetsResponse_TargetResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetsResponse_TargetResult proto.InternalMessageInfo
+
+func (m *TargetsResponse_TargetResult) GetTargetIP() string {
+	if m != nil {
+		return m.TargetIP
+	}
+	return ""
+}
+
+func (m *TargetsResponse_TargetResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *TargetsResponse_TargetResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type StartResponse struct {
+	// Deprecated: Do not use.
+	PingerID              uint32                          `protobuf:"varint,1,opt,name=PingerID,proto3" json:"PingerID,omitempty"`
+	IntervalMillisec      uint64                          `protobuf:"varint,2,opt,name=IntervalMillisec,proto3" json:"IntervalMillisec,omitempty"`
+	TimeoutMillisec       uint64                          `protobuf:"varint,3,opt,name=TimeoutMillisec,proto3" json:"TimeoutMillisec,omitempty"`
+	StatisticsCountsNum   uint64                          `protobuf:"varint,4,opt,name=StatisticsCountsNum,proto3" json:"StatisticsCountsNum,omitempty"`
+	StatisticsIntervalSec uint64                          `protobuf:"varint,5,opt,name=StatisticsIntervalSec,proto3" json:"StatisticsIntervalSec,omitempty"`
+	StartUnixNanosec      uint64                          `protobuf:"varint,6,opt,name=StartUnixNanosec,proto3" json:"StartUnixNanosec,omitempty"`
+	ExpireUnixNanosec     uint64                          `protobuf:"varint,7,opt,name=ExpireUnixNanosec,proto3" json:"ExpireUnixNanosec,omitempty"`
+	Targets               []*TargetsResponse_TargetResult `protobuf:"bytes,8,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Adjustments           []*StartResponse_Adjustment     `protobuf:"bytes,9,rep,name=Adjustments,proto3" json:"Adjustments,omitempty"`
+	Handle                string                          `protobuf:"bytes,10,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	IcmpID                uint32                          `protobuf:"varint,11,opt,name=IcmpID,proto3" json:"IcmpID,omitempty"`
+	Count                 uint64                          `protobuf:"varint,12,opt,name=Count,proto3" json:"Count,omitempty"`
+	ReplayBufferSize      uint64                          `protobuf:"varint,13,opt,name=ReplayBufferSize,proto3" json:"ReplayBufferSize,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                        `json:"-"`
+	XXX_unrecognized      []byte                          `json:"-"`
+	XXX_sizecache         int32                           `json:"-"`
+}
+
+func (m *StartResponse) Reset()         { *m = StartResponse{} }
+func (m *StartResponse) String() string { return proto.CompactTextString(m) }
+func (*StartResponse) ProtoMessage()    {}
+func (*StartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{21}
+}
+
+func (m *StartResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartResponse.Unmarshal(m, b)
+}
+func (m *StartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartResponse.Marshal(b, m, deterministic)
+}
+func (m *StartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartResponse.Merge(m, src)
+}
+func (m *StartResponse) XXX_Size() int {
+	return xxx_messageInfo_StartResponse.Size(m)
+}
+func (m *StartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartResponse proto.InternalMessageInfo
+
+// Deprecated: Do not use.
+func (m *StartResponse) GetPingerID() uint32 {
+	if m != nil {
+		return m.PingerID
+	}
+	return 0
+}
+
+func (m *StartResponse) GetIntervalMillisec() uint64 {
+	if m != nil {
+		return m.IntervalMillisec
+	}
+	return 0
+}
+
+func (m *StartResponse) GetTimeoutMillisec() uint64 {
+	if m != nil {
+		return m.TimeoutMillisec
+	}
+	return 0
+}
+
+func (m *StartResponse) GetStatisticsCountsNum() uint64 {
+	if m != nil {
+		return m.StatisticsCountsNum
+	}
+	return 0
+}
+
+func (m *StartResponse) GetStatisticsIntervalSec() uint64 {
+	if m != nil {
+		return m.StatisticsIntervalSec
+	}
+	return 0
+}
+
+func (m *StartResponse) GetStartUnixNanosec() uint64 {
+	if m != nil {
+		return m.StartUnixNanosec
+	}
+	return 0
+}
+
+func (m *StartResponse) GetExpireUnixNanosec() uint64 {
+	if m != nil {
+		return m.ExpireUnixNanosec
+	}
+	return 0
+}
+
+func (m *StartResponse) GetTargets() []*TargetsResponse_TargetResult {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *StartResponse) GetAdjustments() []*StartResponse_Adjustment {
+	if m != nil {
+		return m.Adjustments
+	}
+	return nil
+}
+
+func (m *StartResponse) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *StartResponse) GetIcmpID() uint32 {
+	if m != nil {
+		return m.IcmpID
+	}
+	return 0
+}
+
+func (m *StartResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *StartResponse) GetReplayBufferSize() uint64 {
+	if m != nil {
+		return m.ReplayBufferSize
+	}
+	return 0
+}
+
+type StartResponse_Adjustment struct {
+	Field                string   `protobuf:"bytes,1,opt,name=Field,proto3" json:"Field,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartResponse_Adjustment) Reset()         { *m = StartResponse_Adjustment{} }
+func (m *StartResponse_Adjustment) String() string { return proto.CompactTextString(m) }
+func (*StartResponse_Adjustment) ProtoMessage()    {}
+func (*StartResponse_Adjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{21, 0}
+}
+
+func (m *StartResponse_Adjustment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartResponse_Adjustment.Unmarshal(m, b)
+}
+func (m *StartResponse_Adjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartResponse_Adjustment.Marshal(b, m, deterministic)
+}
+func (m *StartResponse_Adjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartResponse_Adjustment.Merge(m, src)
+}
+func (m *StartResponse_Adjustment) XXX_Size() int {
+	return xxx_messageInfo_StartResponse_Adjustment.Size(m)
+}
+func (m *StartResponse_Adjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartResponse_Adjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartResponse_Adjustment proto.InternalMessageInfo
+
+func (m *StartResponse_Adjustment) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *StartResponse_Adjustment) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type UpdateRequest struct {
+	IntervalMillisec      uint64   `protobuf:"varint,2,opt,name=IntervalMillisec,proto3" json:"IntervalMillisec,omitempty"`
+	TimeoutMillisec       uint64   `protobuf:"varint,3,opt,name=TimeoutMillisec,proto3" json:"TimeoutMillisec,omitempty"`
+	StatisticsIntervalSec uint64   `protobuf:"varint,4,opt,name=StatisticsIntervalSec,proto3" json:"StatisticsIntervalSec,omitempty"`
+	ExpireUnixNanosec     uint64   `protobuf:"varint,5,opt,name=ExpireUnixNanosec,proto3" json:"ExpireUnixNanosec,omitempty"`
+	Handle                string   `protobuf:"bytes,6,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()    {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{22}
+}
+
+func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
+}
+func (m *UpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRequest.Merge(m, src)
+}
+func (m *UpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRequest.Size(m)
+}
+func (m *UpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRequest proto.InternalMessageInfo
+
+func (m *UpdateRequest) GetIntervalMillisec() uint64 {
+	if m != nil {
+		return m.IntervalMillisec
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetTimeoutMillisec() uint64 {
+	if m != nil {
+		return m.TimeoutMillisec
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetStatisticsIntervalSec() uint64 {
 	if m != nil {
 		return m.StatisticsIntervalSec
 	}
 	return 0
 }
 
-func (m *PingerInfo) GetStartUnixNanosec() uint64 {
+func (m *UpdateRequest) GetExpireUnixNanosec() uint64 {
+	if m != nil {
+		return m.ExpireUnixNanosec
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+type PingOnceRequest struct {
+	Targets              []*StartRequest_IcmpTarget `protobuf:"bytes,1,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Count                uint64                     `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	IntervalMillisec     uint64                     `protobuf:"varint,3,opt,name=IntervalMillisec,proto3" json:"IntervalMillisec,omitempty"`
+	TimeoutMillisec      uint64                     `protobuf:"varint,4,opt,name=TimeoutMillisec,proto3" json:"TimeoutMillisec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *PingOnceRequest) Reset()         { *m = PingOnceRequest{} }
+func (m *PingOnceRequest) String() string { return proto.CompactTextString(m) }
+func (*PingOnceRequest) ProtoMessage()    {}
+func (*PingOnceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{23}
+}
+
+func (m *PingOnceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingOnceRequest.Unmarshal(m, b)
+}
+func (m *PingOnceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingOnceRequest.Marshal(b, m, deterministic)
+}
+func (m *PingOnceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingOnceRequest.Merge(m, src)
+}
+func (m *PingOnceRequest) XXX_Size() int {
+	return xxx_messageInfo_PingOnceRequest.Size(m)
+}
+func (m *PingOnceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingOnceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingOnceRequest proto.InternalMessageInfo
+
+func (m *PingOnceRequest) GetTargets() []*StartRequest_IcmpTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *PingOnceRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PingOnceRequest) GetIntervalMillisec() uint64 {
+	if m != nil {
+		return m.IntervalMillisec
+	}
+	return 0
+}
+
+func (m *PingOnceRequest) GetTimeoutMillisec() uint64 {
+	if m != nil {
+		return m.TimeoutMillisec
+	}
+	return 0
+}
+
+type PingOnceResult struct {
+	Targets              []*PingOnceResult_TargetResult `protobuf:"bytes,1,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Completed            bool                           `protobuf:"varint,2,opt,name=Completed,proto3" json:"Completed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *PingOnceResult) Reset()         { *m = PingOnceResult{} }
+func (m *PingOnceResult) String() string { return proto.CompactTextString(m) }
+func (*PingOnceResult) ProtoMessage()    {}
+func (*PingOnceResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{24}
+}
+
+func (m *PingOnceResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingOnceResult.Unmarshal(m, b)
+}
+func (m *PingOnceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingOnceResult.Marshal(b, m, deterministic)
+}
+func (m *PingOnceResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingOnceResult.Merge(m, src)
+}
+func (m *PingOnceResult) XXX_Size() int {
+	return xxx_messageInfo_PingOnceResult.Size(m)
+}
+func (m *PingOnceResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingOnceResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingOnceResult proto.InternalMessageInfo
+
+func (m *PingOnceResult) GetTargets() []*PingOnceResult_TargetResult {
 	if m != nil {
-		return m.StartUnixNanosec
+		return m.Targets
 	}
-	return 0
+	return nil
 }
 
-func (m *PingerInfo) GetExpireUnixNanosec() uint64 {
+func (m *PingOnceResult) GetCompleted() bool {
 	if m != nil {
-		return m.ExpireUnixNanosec
+		return m.Completed
 	}
-	return 0
+	return false
 }
 
-type PingerInfo_IcmpTarget struct {
-	TargetIP             string   `protobuf:"bytes,1,opt,name=TargetIP,proto3" json:"TargetIP,omitempty"`
-	TargetBinIP          string   `protobuf:"bytes,4,opt,name=TargetBinIP,proto3" json:"TargetBinIP,omitempty"`
-	Comment              string   `protobuf:"bytes,2,opt,name=Comment,proto3" json:"Comment,omitempty"`
-	TargetID             uint32   `protobuf:"fixed32,3,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PingOnceResult_TargetResult struct {
+	TargetIP             string    `protobuf:"bytes,1,opt,name=TargetIP,proto3" json:"TargetIP,omitempty"`
+	Comment              string    `protobuf:"bytes,2,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	TargetID             uint32    `protobuf:"fixed32,3,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	TargetID128          []byte    `protobuf:"bytes,4,opt,name=TargetID128,proto3" json:"TargetID128,omitempty"`
+	TargetBinIP          string    `protobuf:"bytes,5,opt,name=TargetBinIP,proto3" json:"TargetBinIP,omitempty"`
+	ProbeType            ProbeType `protobuf:"varint,6,opt,name=ProbeType,proto3,enum=uPinger.ProbeType" json:"ProbeType,omitempty"`
+	Port                 uint32    `protobuf:"varint,7,opt,name=Port,proto3" json:"Port,omitempty"`
+	SentCount            uint64    `protobuf:"varint,8,opt,name=SentCount,proto3" json:"SentCount,omitempty"`
+	ReceivedCount        uint64    `protobuf:"varint,9,opt,name=ReceivedCount,proto3" json:"ReceivedCount,omitempty"`
+	LossPercent          float64   `protobuf:"fixed64,10,opt,name=LossPercent,proto3" json:"LossPercent,omitempty"`
+	MinRttNanosec        int64     `protobuf:"varint,11,opt,name=MinRttNanosec,proto3" json:"MinRttNanosec,omitempty"`
+	AvgRttNanosec        int64     `protobuf:"varint,12,opt,name=AvgRttNanosec,proto3" json:"AvgRttNanosec,omitempty"`
+	MaxRttNanosec        int64     `protobuf:"varint,13,opt,name=MaxRttNanosec,proto3" json:"MaxRttNanosec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *PingerInfo_IcmpTarget) Reset()         { *m = PingerInfo_IcmpTarget{} }
-func (m *PingerInfo_IcmpTarget) String() string { return proto.CompactTextString(m) }
-func (*PingerInfo_IcmpTarget) ProtoMessage()    {}
-func (*PingerInfo_IcmpTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{5, 0}
+func (m *PingOnceResult_TargetResult) Reset()         { *m = PingOnceResult_TargetResult{} }
+func (m *PingOnceResult_TargetResult) String() string { return proto.CompactTextString(m) }
+func (*PingOnceResult_TargetResult) ProtoMessage()    {}
+func (*PingOnceResult_TargetResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b912ac693319c27c, []int{24, 0}
 }
 
-func (m *PingerInfo_IcmpTarget) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingerInfo_IcmpTarget.Unmarshal(m, b)
+func (m *PingOnceResult_TargetResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingOnceResult_TargetResult.Unmarshal(m, b)
 }
-func (m *PingerInfo_IcmpTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingerInfo_IcmpTarget.Marshal(b, m, deterministic)
+func (m *PingOnceResult_TargetResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingOnceResult_TargetResult.Marshal(b, m, deterministic)
 }
-func (m *PingerInfo_IcmpTarget) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingerInfo_IcmpTarget.Merge(m, src)
+func (m *PingOnceResult_TargetResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingOnceResult_TargetResult.Merge(m, src)
 }
-func (m *PingerInfo_IcmpTarget) XXX_Size() int {
-	return xxx_messageInfo_PingerInfo_IcmpTarget.Size(m)
+func (m *PingOnceResult_TargetResult) XXX_Size() int {
+	return xxx_messageInfo_PingOnceResult_TargetResult.Size(m)
 }
-func (m *PingerInfo_IcmpTarget) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingerInfo_IcmpTarget.DiscardUnknown(m)
+func (m *PingOnceResult_TargetResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingOnceResult_TargetResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingerInfo_IcmpTarget proto.InternalMessageInfo
+var xxx_messageInfo_PingOnceResult_TargetResult proto.InternalMessageInfo
 
-func (m *PingerInfo_IcmpTarget) GetTargetIP() string {
+func (m *PingOnceResult_TargetResult) GetTargetIP() string {
 	if m != nil {
 		return m.TargetIP
 	}
 	return ""
 }
 
-func (m *PingerInfo_IcmpTarget) GetTargetBinIP() string {
-	if m != nil {
-		return m.TargetBinIP
-	}
-	return ""
-}
-
-func (m *PingerInfo_IcmpTarget) GetComment() string {
+func (m *PingOnceResult_TargetResult) GetComment() string {
 	if m != nil {
 		return m.Comment
 	}
 	return ""
 }
 
-func (m *PingerInfo_IcmpTarget) GetTargetID() uint32 {
+func (m *PingOnceResult_TargetResult) GetTargetID() uint32 {
 	if m != nil {
 		return m.TargetID
 	}
 	return 0
 }
 
-type IcmpResult struct {
-	Type                   IcmpResult_ResultType `protobuf:"varint,1,opt,name=type,proto3,enum=uPinger.IcmpResult_ResultType" json:"type,omitempty"`
-	TargetID               uint32                `protobuf:"fixed32,2,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
-	BinPeerIP              uint32                `protobuf:"fixed32,3,opt,name=BinPeerIP,proto3" json:"BinPeerIP,omitempty"`
-	Sequence               int64                 `protobuf:"varint,4,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
-	SendTimeUnixNanosec    int64                 `protobuf:"varint,5,opt,name=SendTimeUnixNanosec,proto3" json:"SendTimeUnixNanosec,omitempty"`
-	ReceiveTimeUnixNanosec int64                 `protobuf:"varint,6,opt,name=ReceiveTimeUnixNanosec,proto3" json:"ReceiveTimeUnixNanosec,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
-	XXX_unrecognized       []byte                `json:"-"`
-	XXX_sizecache          int32                 `json:"-"`
+func (m *PingOnceResult_TargetResult) GetTargetID128() []byte {
+	if m != nil {
+		return m.TargetID128
+	}
+	return nil
 }
 
-func (m *IcmpResult) Reset()         { *m = IcmpResult{} }
-func (m *IcmpResult) String() string { return proto.CompactTextString(m) }
-func (*IcmpResult) ProtoMessage()    {}
-func (*IcmpResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b912ac693319c27c, []int{6}
+func (m *PingOnceResult_TargetResult) GetTargetBinIP() string {
+	if m != nil {
+		return m.TargetBinIP
+	}
+	return ""
 }
 
-func (m *IcmpResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IcmpResult.Unmarshal(m, b)
-}
-func (m *IcmpResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IcmpResult.Marshal(b, m, deterministic)
-}
-func (m *IcmpResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IcmpResult.Merge(m, src)
-}
-func (m *IcmpResult) XXX_Size() int {
-	return xxx_messageInfo_IcmpResult.Size(m)
-}
-func (m *IcmpResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_IcmpResult.DiscardUnknown(m)
+func (m *PingOnceResult_TargetResult) GetProbeType() ProbeType {
+	if m != nil {
+		return m.ProbeType
+	}
+	return ProbeType_ProbeTypeICMP
 }
 
-var xxx_messageInfo_IcmpResult proto.InternalMessageInfo
+func (m *PingOnceResult_TargetResult) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
 
-func (m *IcmpResult) GetType() IcmpResult_ResultType {
+func (m *PingOnceResult_TargetResult) GetSentCount() uint64 {
 	if m != nil {
-		return m.Type
+		return m.SentCount
 	}
-	return IcmpResult_IcmpResultTypeUnknown
+	return 0
 }
 
-func (m *IcmpResult) GetTargetID() uint32 {
+func (m *PingOnceResult_TargetResult) GetReceivedCount() uint64 {
 	if m != nil {
-		return m.TargetID
+		return m.ReceivedCount
 	}
 	return 0
 }
 
-func (m *IcmpResult) GetBinPeerIP() uint32 {
+func (m *PingOnceResult_TargetResult) GetLossPercent() float64 {
 	if m != nil {
-		return m.BinPeerIP
+		return m.LossPercent
 	}
 	return 0
 }
 
-func (m *IcmpResult) GetSequence() int64 {
+func (m *PingOnceResult_TargetResult) GetMinRttNanosec() int64 {
 	if m != nil {
-		return m.Sequence
+		return m.MinRttNanosec
 	}
 	return 0
 }
 
-func (m *IcmpResult) GetSendTimeUnixNanosec() int64 {
+func (m *PingOnceResult_TargetResult) GetAvgRttNanosec() int64 {
 	if m != nil {
-		return m.SendTimeUnixNanosec
+		return m.AvgRttNanosec
 	}
 	return 0
 }
 
-func (m *IcmpResult) GetReceiveTimeUnixNanosec() int64 {
+func (m *PingOnceResult_TargetResult) GetMaxRttNanosec() int64 {
 	if m != nil {
-		return m.ReceiveTimeUnixNanosec
+		return m.MaxRttNanosec
 	}
 	return 0
 }
 
 func init() {
+	proto.RegisterEnum("uPinger.ProbeType", ProbeType_name, ProbeType_value)
+	proto.RegisterEnum("uPinger.PingerMode", PingerMode_name, PingerMode_value)
+	proto.RegisterEnum("uPinger.TargetState", TargetState_name, TargetState_value)
+	proto.RegisterEnum("uPinger.StreamDropPolicy", StreamDropPolicy_name, StreamDropPolicy_value)
+	proto.RegisterEnum("uPinger.TerminationReason", TerminationReason_name, TerminationReason_value)
+	proto.RegisterEnum("uPinger.PingerEvent_EventType", PingerEvent_EventType_name, PingerEvent_EventType_value)
+	proto.RegisterEnum("uPinger.PingerWatchMessage_MessageType", PingerWatchMessage_MessageType_name, PingerWatchMessage_MessageType_value)
+	proto.RegisterEnum("uPinger.SubscribeRequest_ActionType", SubscribeRequest_ActionType_name, SubscribeRequest_ActionType_value)
+	proto.RegisterEnum("uPinger.SubscribeMessage_MessageType", SubscribeMessage_MessageType_name, SubscribeMessage_MessageType_value)
 	proto.RegisterEnum("uPinger.IcmpResult_ResultType", IcmpResult_ResultType_name, IcmpResult_ResultType_value)
+	proto.RegisterEnum("uPinger.HopTable_HopTableType", HopTable_HopTableType_name, HopTable_HopTableType_value)
 	proto.RegisterType((*Null)(nil), "uPinger.Null")
+	proto.RegisterType((*PingerSummary)(nil), "uPinger.PingerSummary")
+	proto.RegisterType((*PingerSummary_TargetTotal)(nil), "uPinger.PingerSummary.TargetTotal")
 	proto.RegisterType((*StartRequest)(nil), "uPinger.StartRequest")
 	proto.RegisterType((*StartRequest_IcmpTarget)(nil), "uPinger.StartRequest.IcmpTarget")
 	proto.RegisterType((*Statistics)(nil), "uPinger.Statistics")
 	proto.RegisterType((*Statistics_SuccessCount)(nil), "uPinger.Statistics.SuccessCount")
+	proto.RegisterType((*Statistics_SuccessCount_Window)(nil), "uPinger.Statistics.SuccessCount.Window")
 	proto.RegisterType((*PingerID)(nil), "uPinger.PingerID")
+	proto.RegisterType((*StreamRequest)(nil), "uPinger.StreamRequest")
 	proto.RegisterType((*PingerList)(nil), "uPinger.PingerList")
 	proto.RegisterType((*PingerList_PingerSumally)(nil), "uPinger.PingerList.PingerSumally")
 	proto.RegisterType((*PingerInfo)(nil), "uPinger.PingerInfo")
 	proto.RegisterType((*PingerInfo_IcmpTarget)(nil), "uPinger.PingerInfo.IcmpTarget")
+	proto.RegisterType((*PingerEvent)(nil), "uPinger.PingerEvent")
+	proto.RegisterType((*PingerWatchMessage)(nil), "uPinger.PingerWatchMessage")
+	proto.RegisterType((*SubscribeRequest)(nil), "uPinger.SubscribeRequest")
+	proto.RegisterType((*SubscribeMessage)(nil), "uPinger.SubscribeMessage")
+	proto.RegisterType((*BatchStreamRequest)(nil), "uPinger.BatchStreamRequest")
+	proto.RegisterType((*IcmpResultBatch)(nil), "uPinger.IcmpResultBatch")
+	proto.RegisterType((*IcmpResultBatch_Target)(nil), "uPinger.IcmpResultBatch.Target")
+	proto.RegisterType((*IcmpResultBatch_Result)(nil), "uPinger.IcmpResultBatch.Result")
 	proto.RegisterType((*IcmpResult)(nil), "uPinger.IcmpResult")
+	proto.RegisterType((*HopTable)(nil), "uPinger.HopTable")
+	proto.RegisterType((*HopTable_Hop)(nil), "uPinger.HopTable.Hop")
+	proto.RegisterType((*HopTable_Target)(nil), "uPinger.HopTable.Target")
+	proto.RegisterType((*HopTable_PathChange)(nil), "uPinger.HopTable.PathChange")
+	proto.RegisterType((*PathMTURequest)(nil), "uPinger.PathMTURequest")
+	proto.RegisterType((*PathMTUResult)(nil), "uPinger.PathMTUResult")
+	proto.RegisterType((*PathMTUResult_FragmentationNeeded)(nil), "uPinger.PathMTUResult.FragmentationNeeded")
+	proto.RegisterType((*TargetStateChange)(nil), "uPinger.TargetStateChange")
+	proto.RegisterType((*TargetsRequest)(nil), "uPinger.TargetsRequest")
+	proto.RegisterType((*TargetsResponse)(nil), "uPinger.TargetsResponse")
+	proto.RegisterType((*TargetsResponse_TargetResult)(nil), "uPinger.TargetsResponse.TargetResult")
+	proto.RegisterType((*StartResponse)(nil), "uPinger.StartResponse")
+	proto.RegisterType((*StartResponse_Adjustment)(nil), "uPinger.StartResponse.Adjustment")
+	proto.RegisterType((*UpdateRequest)(nil), "uPinger.UpdateRequest")
+	proto.RegisterType((*PingOnceRequest)(nil), "uPinger.PingOnceRequest")
+	proto.RegisterType((*PingOnceResult)(nil), "uPinger.PingOnceResult")
+	proto.RegisterType((*PingOnceResult_TargetResult)(nil), "uPinger.PingOnceResult.TargetResult")
 }
 
 func init() {
@@ -707,54 +4054,243 @@ func init() {
 }
 
 var fileDescriptor_b912ac693319c27c = []byte{
-	// 739 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8e, 0xe3, 0x5c, 0xda, 0xd3, 0xa6, 0x7f, 0x3a, 0xfd, 0x8b, 0x52, 0x0b, 0x50, 0x6a, 0x01,
-	0x8a, 0x2a, 0x14, 0x55, 0x2d, 0x20, 0x6e, 0x0b, 0x68, 0x53, 0x55, 0x91, 0x4a, 0x15, 0x39, 0xe9,
-	0x03, 0x04, 0xf7, 0xb4, 0x1a, 0xe1, 0x8c, 0x8d, 0x3d, 0x2e, 0xed, 0x0e, 0x89, 0x1d, 0x6b, 0xde,
-	0x00, 0x09, 0x1e, 0x89, 0x07, 0xe1, 0x05, 0x90, 0xc7, 0xb7, 0xf1, 0x25, 0x51, 0xd9, 0xb1, 0x8a,
-	0xcf, 0x39, 0xdf, 0x37, 0xe7, 0x3e, 0x13, 0x58, 0x73, 0x28, 0xbb, 0x3c, 0x76, 0x1d, 0xb3, 0xef,
-	0xb8, 0x36, 0xb7, 0x49, 0xd3, 0x1f, 0x51, 0x76, 0x89, 0xae, 0xde, 0x80, 0xda, 0xa9, 0x6f, 0x59,
-	0xfa, 0x37, 0x15, 0x56, 0xc7, 0x7c, 0xea, 0x72, 0x03, 0x3f, 0xfa, 0xe8, 0x71, 0xd2, 0x85, 0x95,
-	0x01, 0x7a, 0xa6, 0x4b, 0x1d, 0x4e, 0x6d, 0xd6, 0x51, 0xba, 0x4a, 0x6f, 0xd9, 0x90, 0x55, 0xe4,
-	0x25, 0x34, 0x27, 0x53, 0xf7, 0x12, 0xb9, 0xd7, 0xa9, 0x76, 0xd5, 0xde, 0xca, 0x5e, 0xb7, 0x1f,
-	0x9d, 0xda, 0x97, 0x4f, 0xea, 0x0f, 0xcd, 0x99, 0x13, 0x02, 0x8d, 0x98, 0x40, 0x76, 0xa0, 0x3d,
-	0x64, 0x1c, 0xdd, 0xab, 0xa9, 0xf5, 0x8e, 0x5a, 0x16, 0xf5, 0xd0, 0xec, 0xa8, 0x5d, 0xa5, 0x57,
-	0x33, 0x0a, 0x7a, 0xd2, 0x83, 0xff, 0x26, 0x74, 0x86, 0xb6, 0xcf, 0x13, 0x68, 0x4d, 0x40, 0xf3,
-	0x6a, 0xb2, 0x0b, 0x1b, 0x63, 0x3e, 0xe5, 0xd4, 0xe3, 0xd4, 0xf4, 0x0e, 0x6d, 0x9f, 0x71, 0xef,
-	0xd4, 0x9f, 0x75, 0xea, 0x02, 0x5d, 0x66, 0x22, 0x0f, 0xa0, 0x35, 0xe6, 0xb6, 0x13, 0x86, 0x3d,
-	0x46, 0xb3, 0xd3, 0x10, 0xd8, 0xac, 0x92, 0x3c, 0x81, 0xcd, 0x94, 0x1c, 0xc7, 0x17, 0xa0, 0x9b,
-	0x02, 0x5d, 0x6e, 0xd4, 0x0e, 0x00, 0xd2, 0xd4, 0x89, 0x06, 0x4b, 0xe1, 0xd7, 0x70, 0x14, 0x15,
-	0x33, 0x91, 0x49, 0x07, 0x9a, 0x87, 0xf6, 0x6c, 0x86, 0x8c, 0x77, 0xaa, 0xc2, 0x14, 0x8b, 0xfa,
-	0x57, 0x05, 0x20, 0x3d, 0x5d, 0x2e, 0xb9, 0x52, 0x2c, 0x79, 0x84, 0xea, 0x8f, 0x7d, 0xd3, 0x44,
-	0x2f, 0xcc, 0x32, 0x29, 0xb9, 0xf6, 0x06, 0x56, 0x65, 0x83, 0x14, 0xd0, 0x40, 0x04, 0xd4, 0x4c,
-	0x02, 0x1a, 0x90, 0xff, 0xa1, 0x2e, 0x40, 0x22, 0x1c, 0xd5, 0x08, 0x05, 0xfd, 0x11, 0x2c, 0x85,
-	0xce, 0x86, 0x83, 0x80, 0x1d, 0x7f, 0x0b, 0x76, 0xcb, 0x48, 0x64, 0xfd, 0xb7, 0x02, 0x10, 0x0a,
-	0x27, 0xd4, 0xe3, 0xe4, 0x15, 0x34, 0x43, 0x29, 0x0e, 0x7a, 0x3b, 0x09, 0x3a, 0x45, 0x45, 0x9f,
-	0x63, 0x7f, 0x36, 0xb5, 0xac, 0x1b, 0x23, 0x66, 0x68, 0x3f, 0x15, 0x68, 0x65, 0x4c, 0x8b, 0x3c,
-	0xe7, 0x87, 0xb6, 0x5a, 0x1c, 0xda, 0x1d, 0x68, 0x8b, 0xe1, 0x3c, 0x63, 0xf4, 0xfa, 0x74, 0xca,
-	0x6c, 0x69, 0xf0, 0xf2, 0x7a, 0xf2, 0x18, 0xd6, 0x8f, 0xae, 0x1d, 0xea, 0xa2, 0x0c, 0x0e, 0x47,
-	0xaf, 0x68, 0xd0, 0xbf, 0xd4, 0xe2, 0xac, 0x87, 0xec, 0xc2, 0xbe, 0xc5, 0xfe, 0x3c, 0xcf, 0xef,
-	0xcf, 0xfd, 0x5c, 0x5d, 0x82, 0x73, 0xfe, 0xe9, 0xed, 0x99, 0xbb, 0x17, 0x8d, 0x05, 0x7b, 0x51,
-	0xda, 0x82, 0xa5, 0xbf, 0x69, 0x41, 0x73, 0x4e, 0x0b, 0xb4, 0xcf, 0xca, 0xad, 0x57, 0xae, 0x0b,
-	0x2b, 0xe1, 0xf7, 0x01, 0x65, 0xc3, 0x91, 0x28, 0xc9, 0xb2, 0x21, 0xab, 0xe6, 0x2f, 0x65, 0x66,
-	0x73, 0xd4, 0xec, 0xe6, 0xe8, 0x3f, 0xd4, 0x30, 0x04, 0x03, 0x3d, 0xdf, 0xe2, 0x64, 0x0f, 0x6a,
-	0xfc, 0xc6, 0x41, 0xe1, 0x7e, 0x4d, 0x6a, 0x70, 0x0a, 0xe9, 0x87, 0x3f, 0x93, 0x1b, 0x07, 0x0d,
-	0x81, 0xcd, 0x1c, 0x5f, 0xcd, 0x2d, 0xe6, 0x5d, 0x58, 0x3e, 0xa0, 0x6c, 0x84, 0xe8, 0x0e, 0x47,
-	0x91, 0xef, 0x54, 0x11, 0x30, 0xc7, 0xc1, 0xa5, 0xcb, 0x4c, 0x14, 0x19, 0xa9, 0x46, 0x22, 0x8b,
-	0xee, 0x22, 0x3b, 0x0f, 0x9a, 0x2e, 0xd7, 0xb2, 0x2e, 0x60, 0x65, 0x26, 0xf2, 0x0c, 0xee, 0x18,
-	0x68, 0x22, 0xbd, 0xc2, 0x3c, 0xa9, 0x21, 0x48, 0x73, 0xac, 0xfa, 0x77, 0x05, 0x20, 0x4d, 0x8a,
-	0x6c, 0xc1, 0x66, 0x9a, 0x6d, 0xa0, 0x39, 0x63, 0x1f, 0x98, 0xfd, 0x89, 0xb5, 0x2b, 0x45, 0x53,
-	0x74, 0x62, 0x5b, 0x21, 0x0f, 0x61, 0xbb, 0xd4, 0xf4, 0xf6, 0x82, 0xa3, 0x1b, 0x4d, 0x6e, 0xbb,
-	0x4a, 0xee, 0xc1, 0x56, 0x16, 0x36, 0x99, 0x9c, 0x1c, 0x5d, 0x9b, 0x88, 0xe7, 0x78, 0xde, 0x56,
-	0x8b, 0x0e, 0x62, 0x66, 0x6d, 0xef, 0x57, 0x15, 0x1a, 0x61, 0x33, 0xc8, 0x3e, 0xd4, 0xc5, 0xe0,
-	0x91, 0xcd, 0xd2, 0x07, 0x4c, 0x5b, 0xcf, 0xef, 0xe5, 0x40, 0xaf, 0x90, 0x1d, 0xa8, 0x05, 0x8f,
-	0x04, 0x29, 0x1a, 0xb5, 0x56, 0xa2, 0x12, 0x4f, 0x6b, 0x85, 0x3c, 0x85, 0xd6, 0x31, 0x72, 0xe9,
-	0x4a, 0xcc, 0x22, 0xb4, 0x8d, 0x92, 0x0b, 0x51, 0xaf, 0x90, 0x17, 0x12, 0x4d, 0xdc, 0x29, 0x25,
-	0xbe, 0x36, 0x4a, 0xee, 0x0c, 0xbd, 0x42, 0x5e, 0xc3, 0xda, 0x31, 0x72, 0x4f, 0x7a, 0x3a, 0x16,
-	0x72, 0x53, 0x9c, 0x5e, 0xd9, 0x55, 0x62, 0xb6, 0x34, 0xc7, 0x0b, 0xd9, 0x29, 0x2e, 0x60, 0xbf,
-	0x6f, 0x88, 0xbf, 0x18, 0xfb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x5a, 0x75, 0x07, 0x74,
-	0x08, 0x00, 0x00,
+	// 3761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4d, 0x6c, 0x1c, 0x49,
+	0x15, 0x76, 0x4f, 0xf7, 0xfc, 0xbd, 0xf9, 0x71, 0xbb, 0x1c, 0x3b, 0x93, 0x49, 0x36, 0x71, 0x9a,
+	0xec, 0xc6, 0x78, 0x17, 0x2b, 0x78, 0x37, 0x28, 0xde, 0xbf, 0xc8, 0x3f, 0x71, 0xe2, 0x55, 0xec,
+	0x0c, 0x6d, 0x47, 0x2b, 0x71, 0x40, 0xea, 0x4c, 0x57, 0xec, 0x61, 0x67, 0xba, 0x87, 0x9e, 0x1e,
+	0xc7, 0xe6, 0x88, 0x04, 0x27, 0x38, 0x71, 0xe2, 0x00, 0x08, 0xf6, 0xce, 0x85, 0xc3, 0x8a, 0x1b,
+	0xda, 0x03, 0x07, 0x24, 0x84, 0x56, 0xdc, 0xe1, 0xca, 0x95, 0x15, 0xe2, 0x00, 0x07, 0x50, 0x55,
+	0x75, 0x4f, 0x55, 0x57, 0x57, 0x7b, 0x66, 0x92, 0x80, 0x72, 0x49, 0xa6, 0xde, 0x7b, 0x55, 0x5d,
+	0xf5, 0xea, 0xab, 0xf7, 0x53, 0xaf, 0x0c, 0xf5, 0x7e, 0xc7, 0x3b, 0xba, 0x1f, 0xf4, 0xdb, 0xab,
+	0xfd, 0xc0, 0x0f, 0x7d, 0x54, 0x1c, 0xb6, 0x3a, 0xde, 0x11, 0x0e, 0xac, 0x02, 0x18, 0xfb, 0xc3,
+	0x6e, 0xd7, 0xfa, 0xcc, 0x80, 0x1a, 0x23, 0x1d, 0x0c, 0x7b, 0x3d, 0x27, 0x38, 0x43, 0x8b, 0x50,
+	0x78, 0xe0, 0x78, 0x6e, 0x17, 0x37, 0xb4, 0x25, 0x6d, 0xb9, 0x6c, 0x47, 0x2d, 0xb4, 0x06, 0x05,
+	0x1b, 0x3b, 0x03, 0xdf, 0x6b, 0xe4, 0x96, 0xb4, 0xe5, 0xfa, 0x5a, 0x73, 0x35, 0x1a, 0x6b, 0xf5,
+	0x10, 0x07, 0xbd, 0x8e, 0xe7, 0x84, 0x1d, 0xdf, 0x63, 0x12, 0x76, 0x24, 0x89, 0xae, 0x40, 0xf9,
+	0x20, 0xf4, 0xfb, 0x7d, 0xec, 0x6e, 0x9e, 0x35, 0x74, 0x3a, 0x1c, 0x27, 0xa0, 0x0b, 0x90, 0xbf,
+	0x17, 0x04, 0x7e, 0xd0, 0x30, 0x28, 0x87, 0x35, 0xd0, 0x0a, 0x98, 0x07, 0xa1, 0x13, 0x84, 0x8f,
+	0xbd, 0xce, 0xe9, 0xbe, 0xe3, 0xf9, 0x03, 0xdc, 0x6e, 0xe4, 0x97, 0xb4, 0x65, 0xc3, 0x4e, 0xd1,
+	0xd1, 0x1b, 0x50, 0xbf, 0xe7, 0xb9, 0xa2, 0x64, 0x81, 0x4a, 0x4a, 0x54, 0xf4, 0x3e, 0x14, 0x0f,
+	0x9d, 0xe0, 0x08, 0x87, 0x83, 0x46, 0x71, 0x49, 0x5f, 0xae, 0xac, 0x59, 0xa3, 0xc9, 0x27, 0x16,
+	0xbf, 0xca, 0xa4, 0x0e, 0xfd, 0xd0, 0xe9, 0xda, 0x71, 0x97, 0xe6, 0x4f, 0x72, 0x50, 0x11, 0x18,
+	0xa8, 0x09, 0x25, 0xd6, 0xdc, 0xdd, 0xa6, 0x3a, 0x2a, 0xda, 0xa3, 0x36, 0x5a, 0x8a, 0x45, 0x77,
+	0xb7, 0xbf, 0xbe, 0x76, 0x87, 0xaa, 0xaa, 0x6a, 0x8b, 0x24, 0xa1, 0x77, 0x2b, 0x52, 0xc9, 0xa8,
+	0x8d, 0x6e, 0x41, 0xb9, 0x15, 0xf8, 0x4f, 0xf0, 0xe1, 0x59, 0x1f, 0x53, 0xad, 0xd4, 0xd7, 0x10,
+	0x9f, 0x69, 0xcc, 0xb1, 0xb9, 0x10, 0x42, 0x60, 0xb4, 0xfc, 0x20, 0xa4, 0x1a, 0xaa, 0xd9, 0xf4,
+	0x37, 0xd5, 0x3a, 0xf6, 0xc2, 0x2d, 0x7f, 0xe8, 0x85, 0x91, 0x42, 0x38, 0x01, 0xdd, 0x80, 0x9a,
+	0x8d, 0xdb, 0xb8, 0x73, 0x82, 0x5d, 0x26, 0x51, 0xa4, 0x12, 0x49, 0x22, 0x59, 0xc7, 0x43, 0x7f,
+	0x30, 0x68, 0xe1, 0xa0, 0x8d, 0xbd, 0xb0, 0x51, 0x5a, 0xd2, 0x96, 0x35, 0x5b, 0x24, 0x59, 0x9f,
+	0x16, 0xa1, 0x4a, 0x37, 0xc4, 0xc6, 0xdf, 0x1d, 0xe2, 0x01, 0xed, 0xb2, 0x8d, 0x07, 0xed, 0xa0,
+	0xd3, 0x27, 0x48, 0x88, 0xd0, 0x23, 0x92, 0xd0, 0xbb, 0x7c, 0x1b, 0x72, 0x74, 0x1b, 0x96, 0x46,
+	0x8b, 0x13, 0x47, 0x5a, 0xdd, 0x6d, 0xf7, 0xfa, 0x4c, 0x70, 0xb4, 0x09, 0x04, 0x16, 0xbb, 0x5e,
+	0x88, 0x83, 0x13, 0xa7, 0xbb, 0xd7, 0xe9, 0x76, 0x3b, 0x64, 0xb3, 0x75, 0x06, 0x0b, 0x99, 0x8e,
+	0x96, 0x61, 0xf6, 0xb0, 0xd3, 0xc3, 0xfe, 0x30, 0x1c, 0x89, 0x1a, 0x54, 0x54, 0x26, 0xa3, 0x5b,
+	0x30, 0x7f, 0x10, 0x3a, 0x61, 0x67, 0x10, 0x76, 0xda, 0x03, 0xba, 0xf2, 0xc1, 0xfe, 0xb0, 0x17,
+	0xe1, 0x4d, 0xc5, 0x22, 0xea, 0x23, 0x08, 0x8e, 0x60, 0x33, 0x42, 0x5c, 0x92, 0x88, 0xde, 0x81,
+	0x05, 0xde, 0x39, 0x9e, 0x1f, 0x91, 0x66, 0xca, 0x56, 0x33, 0xd1, 0x4d, 0x30, 0xf6, 0x7c, 0x17,
+	0x53, 0x6d, 0xd7, 0xd7, 0xe6, 0x25, 0x8c, 0x12, 0x96, 0x4d, 0x05, 0x50, 0x03, 0x8a, 0x7b, 0xce,
+	0xe9, 0x03, 0xbf, 0x3f, 0x68, 0x94, 0xe9, 0x80, 0x71, 0x13, 0x99, 0xa0, 0x1f, 0x1e, 0x3e, 0x6c,
+	0x00, 0xa5, 0x92, 0x9f, 0x94, 0xf2, 0xe8, 0xa0, 0x51, 0x89, 0x28, 0x8f, 0x0e, 0xc8, 0x46, 0xb5,
+	0x9c, 0xb3, 0xae, 0xef, 0xb8, 0x07, 0x9d, 0xef, 0xe1, 0x46, 0x95, 0x72, 0x44, 0x12, 0x39, 0x57,
+	0x51, 0xb3, 0xe5, 0x84, 0x21, 0x0e, 0xbc, 0x46, 0x8d, 0x02, 0x59, 0xa2, 0x22, 0x0b, 0xaa, 0xdb,
+	0xbe, 0x17, 0xee, 0x04, 0xce, 0x51, 0x8f, 0xc0, 0xa4, 0xbe, 0xa4, 0x2d, 0x97, 0xec, 0x04, 0x0d,
+	0xad, 0xc1, 0x05, 0xbe, 0xda, 0x8f, 0x3b, 0x9e, 0xeb, 0x3f, 0x1b, 0x10, 0x4d, 0xcc, 0x2e, 0xe9,
+	0xcb, 0x86, 0xad, 0xe4, 0x11, 0x25, 0x6f, 0xfb, 0xcf, 0x3c, 0x02, 0x37, 0x86, 0x51, 0x93, 0x29,
+	0x39, 0x41, 0x24, 0xb3, 0x7c, 0xdc, 0x3f, 0x18, 0xb6, 0xdb, 0x38, 0x16, 0x9b, 0x63, 0xa7, 0x3f,
+	0x49, 0x25, 0x9b, 0xbc, 0x8d, 0x8f, 0x02, 0xc7, 0xc5, 0xae, 0x88, 0x69, 0x44, 0x31, 0xad, 0x62,
+	0x11, 0xb0, 0x3d, 0xf0, 0xbb, 0x2e, 0xf9, 0xdc, 0x08, 0x41, 0xf3, 0x0c, 0x6c, 0x32, 0x9d, 0xd8,
+	0xcb, 0x83, 0x30, 0xe8, 0xb4, 0xc3, 0xc6, 0x05, 0xba, 0xfa, 0xa8, 0x45, 0xac, 0x1b, 0x9b, 0xd4,
+	0x02, 0xed, 0xc8, 0x1a, 0x64, 0x64, 0x1b, 0xf7, 0xbb, 0xce, 0xd9, 0xe6, 0xf0, 0xe9, 0x53, 0x1c,
+	0xd0, 0x0d, 0x58, 0x64, 0x23, 0xcb, 0xf4, 0xe6, 0x26, 0x00, 0x3f, 0x09, 0x09, 0xbb, 0xa1, 0x49,
+	0x76, 0xa3, 0x01, 0xc5, 0x2d, 0xbf, 0x47, 0xb7, 0x20, 0x47, 0x59, 0x71, 0xd3, 0xfa, 0x05, 0x00,
+	0x70, 0x15, 0x8b, 0x27, 0x50, 0x4b, 0x9f, 0xc0, 0x48, 0x6a, 0x55, 0xd4, 0x1e, 0x3f, 0x81, 0x17,
+	0x20, 0xbf, 0xd3, 0xf1, 0x9c, 0x2e, 0xfd, 0x44, 0xc9, 0x66, 0x0d, 0x74, 0x0b, 0x8a, 0x91, 0xf1,
+	0xa4, 0xc7, 0xb1, 0xb2, 0xb6, 0xa8, 0x36, 0xad, 0x76, 0x2c, 0x46, 0xb6, 0xed, 0x20, 0x0c, 0xb0,
+	0xd3, 0x3b, 0x20, 0xc7, 0xdd, 0x6b, 0xe3, 0xe8, 0x70, 0x4a, 0x54, 0x0a, 0xae, 0x80, 0xfa, 0x0a,
+	0xa6, 0x47, 0x76, 0x28, 0x13, 0xb4, 0xe6, 0xef, 0x4a, 0x50, 0x4d, 0xec, 0xf5, 0x79, 0xb6, 0x79,
+	0xb4, 0x23, 0x64, 0x01, 0x7a, 0xbc, 0x23, 0x92, 0xc5, 0xd6, 0xd3, 0x16, 0xfb, 0xe5, 0x58, 0x65,
+	0xc9, 0xa2, 0x16, 0x52, 0x16, 0x95, 0xcc, 0xdd, 0x0e, 0x43, 0x6e, 0x94, 0x75, 0x7b, 0xd4, 0x26,
+	0x27, 0x62, 0xaf, 0xe3, 0xd9, 0x61, 0x18, 0x3b, 0xba, 0x12, 0x15, 0x48, 0x12, 0x89, 0xd4, 0xc6,
+	0xc9, 0x91, 0x20, 0x55, 0x66, 0x52, 0x09, 0x22, 0x1d, 0xcb, 0x39, 0x15, 0xa4, 0x20, 0x1a, 0x4b,
+	0x24, 0x32, 0x3f, 0xec, 0xba, 0xf8, 0x44, 0x10, 0xac, 0x50, 0xc1, 0x14, 0x9d, 0x8c, 0xd8, 0xba,
+	0x7d, 0x4b, 0x10, 0xac, 0xb2, 0x11, 0x13, 0x44, 0x2a, 0xb5, 0x7e, 0x5b, 0x90, 0xaa, 0x45, 0x52,
+	0xeb, 0xb7, 0x65, 0xa9, 0x75, 0x41, 0xaa, 0x1e, 0x4b, 0xad, 0x27, 0xa5, 0x3e, 0xea, 0x10, 0x23,
+	0x14, 0x4b, 0xcd, 0x32, 0xa9, 0x04, 0x11, 0x6d, 0x40, 0x31, 0xb2, 0x2a, 0x0d, 0x93, 0xc2, 0xfd,
+	0xe6, 0x38, 0xb8, 0xaf, 0x32, 0x79, 0x3b, 0xee, 0xd7, 0xfc, 0x8b, 0x0e, 0x05, 0xf6, 0x9b, 0xf8,
+	0x55, 0xf6, 0x8b, 0x98, 0x2f, 0x8d, 0xf9, 0xd5, 0x11, 0x21, 0xe9, 0x75, 0x19, 0xc2, 0xce, 0xf3,
+	0xba, 0x3a, 0x9b, 0xef, 0xb9, 0x5e, 0xd7, 0x38, 0x1f, 0x23, 0xf9, 0x71, 0x18, 0x29, 0x4c, 0x84,
+	0x91, 0xe2, 0x44, 0x18, 0x29, 0x4d, 0x8a, 0x91, 0xf2, 0xa4, 0x18, 0x81, 0x89, 0x30, 0x52, 0x99,
+	0x08, 0x23, 0xd5, 0x89, 0x30, 0x52, 0x53, 0x60, 0xc4, 0xda, 0x84, 0x12, 0x83, 0xc4, 0xee, 0x36,
+	0xba, 0xca, 0x7f, 0xd3, 0x0d, 0xae, 0x6d, 0xe6, 0x1a, 0x9a, 0xcd, 0xf9, 0x3c, 0x36, 0xce, 0x89,
+	0xb1, 0xb1, 0xf5, 0xa5, 0x0e, 0x35, 0x66, 0xbd, 0xe2, 0x60, 0xe8, 0x39, 0x47, 0x42, 0xab, 0x80,
+	0x98, 0x1f, 0xd8, 0x09, 0x7c, 0x6e, 0x20, 0x59, 0xa0, 0xa3, 0xe0, 0x90, 0x40, 0x83, 0x53, 0xc5,
+	0x40, 0x98, 0xd9, 0x54, 0x35, 0x13, 0xed, 0x40, 0x6d, 0xa7, 0xd3, 0x0d, 0x71, 0x10, 0x3b, 0x83,
+	0xfc, 0x84, 0xe1, 0x58, 0xb2, 0x1b, 0xf1, 0xed, 0x8c, 0xb0, 0xef, 0x7b, 0x11, 0x92, 0x1f, 0x79,
+	0xdd, 0x33, 0x0a, 0xbc, 0x92, 0xad, 0xe4, 0x11, 0x6f, 0xcc, 0xe8, 0x49, 0xac, 0x32, 0x14, 0xaa,
+	0x58, 0xc4, 0x61, 0x30, 0xf2, 0xbd, 0x13, 0x1c, 0x9c, 0xed, 0x87, 0xc7, 0x14, 0x8c, 0x86, 0x2d,
+	0x51, 0xd1, 0x3a, 0x00, 0x71, 0x0e, 0x2d, 0xbf, 0xdb, 0x69, 0x9f, 0x51, 0x1c, 0xd6, 0xd7, 0x2e,
+	0x09, 0x4b, 0x22, 0xfb, 0xc3, 0x05, 0x6c, 0x41, 0x98, 0x2c, 0x64, 0xb3, 0xeb, 0xb7, 0x3f, 0x91,
+	0xc3, 0x46, 0x16, 0x47, 0x29, 0x79, 0xd6, 0xcf, 0x74, 0x00, 0x36, 0xf6, 0xc3, 0xce, 0x20, 0x44,
+	0xef, 0x41, 0x91, 0xb5, 0x62, 0xd7, 0x7a, 0x5d, 0x72, 0x84, 0x44, 0x8a, 0xfb, 0x44, 0xa7, 0xdb,
+	0x3d, 0xb3, 0xe3, 0x1e, 0xcd, 0x2f, 0x72, 0x50, 0x4b, 0xb0, 0xc6, 0x02, 0x48, 0x8a, 0xb6, 0x73,
+	0xe9, 0x68, 0x5b, 0x95, 0x48, 0xe9, 0x19, 0x89, 0xd4, 0x5b, 0x30, 0x77, 0xef, 0xb4, 0xdf, 0x09,
+	0x70, 0x1a, 0x42, 0x69, 0x06, 0x01, 0x6f, 0xcb, 0x19, 0x0e, 0xb0, 0x4b, 0x4d, 0x50, 0xc9, 0x8e,
+	0x5a, 0x64, 0x14, 0xf6, 0x2b, 0x9d, 0x91, 0xa5, 0x19, 0x04, 0xea, 0x34, 0x9f, 0x62, 0x1c, 0x11,
+	0x07, 0x86, 0xad, 0xe0, 0x08, 0x47, 0xa6, 0x94, 0x38, 0x32, 0x8b, 0x50, 0x20, 0x08, 0xdd, 0xdd,
+	0xa6, 0x5b, 0x5e, 0xb3, 0xa3, 0x96, 0xf5, 0x87, 0x6a, 0xbc, 0x3f, 0xbb, 0xde, 0x53, 0x7f, 0x82,
+	0xf4, 0xe4, 0x8e, 0x9c, 0x9e, 0x5c, 0x95, 0x76, 0x90, 0x8c, 0xf3, 0x4a, 0x27, 0x27, 0x99, 0x69,
+	0x47, 0xe1, 0xbc, 0xb4, 0x43, 0x05, 0x94, 0xd2, 0x34, 0x40, 0x29, 0x66, 0x01, 0x25, 0x4e, 0x68,
+	0xca, 0x53, 0x24, 0x34, 0xa0, 0x4c, 0x68, 0x2a, 0xa9, 0x84, 0xa6, 0x9a, 0x99, 0xd0, 0xd4, 0x26,
+	0x49, 0x68, 0xea, 0x13, 0x25, 0x34, 0xb3, 0x53, 0x24, 0x34, 0xe6, 0x34, 0x09, 0xcd, 0xdc, 0x64,
+	0x09, 0x0d, 0x9a, 0x26, 0xa1, 0x99, 0x9f, 0x2e, 0xa1, 0xb9, 0x90, 0x9d, 0xd0, 0x44, 0xa7, 0x7b,
+	0x61, 0xfc, 0xe9, 0x5e, 0x9c, 0xee, 0x74, 0x5f, 0x9c, 0xe0, 0x74, 0x37, 0x32, 0x4e, 0xf7, 0x25,
+	0xf1, 0x74, 0xf3, 0x60, 0xbe, 0x39, 0x2e, 0xbd, 0xba, 0xac, 0x4e, 0xaf, 0x88, 0x16, 0x6d, 0x3c,
+	0x18, 0x76, 0xc3, 0x41, 0x22, 0xcd, 0xb8, 0xc2, 0x8e, 0x97, 0x82, 0x85, 0xbe, 0x01, 0x8b, 0x7c,
+	0x77, 0x13, 0x9d, 0x5e, 0xa3, 0x9d, 0x32, 0xb8, 0xe4, 0x58, 0x3e, 0xf0, 0xfb, 0x87, 0xce, 0x93,
+	0x2e, 0x4e, 0x76, 0xbb, 0xca, 0x8e, 0xa5, 0x92, 0x89, 0xde, 0x85, 0x06, 0xb3, 0x2f, 0x64, 0x54,
+	0xa9, 0xe3, 0x35, 0xda, 0x31, 0x93, 0x4f, 0xb4, 0xcf, 0xce, 0x58, 0xa2, 0xd7, 0x12, 0xd3, 0x7e,
+	0x9a, 0xc3, 0xc2, 0x88, 0x41, 0xdf, 0xf7, 0x06, 0xd2, 0x87, 0xae, 0xc7, 0x61, 0x84, 0x82, 0xd9,
+	0xfc, 0x9b, 0x36, 0x71, 0x86, 0x3a, 0xca, 0xb2, 0x36, 0x3b, 0xde, 0x6e, 0x2b, 0xba, 0xf1, 0x13,
+	0x49, 0xd9, 0x39, 0x6c, 0x22, 0xa7, 0xd3, 0xcf, 0xbf, 0x6f, 0xcb, 0x8f, 0xc9, 0xde, 0x0a, 0xd3,
+	0x64, 0x6f, 0x45, 0x9e, 0xbd, 0x59, 0xbf, 0x34, 0xa0, 0xc2, 0xfa, 0xdc, 0x3b, 0x61, 0x46, 0xc0,
+	0xa0, 0x03, 0x6a, 0x74, 0x40, 0xd9, 0x51, 0x50, 0x99, 0x55, 0xfa, 0x2f, 0x1d, 0x9c, 0xca, 0x66,
+	0xc6, 0x7c, 0x4b, 0x50, 0x49, 0xfb, 0x68, 0x91, 0x24, 0xfb, 0x2e, 0x23, 0xed, 0xbb, 0x84, 0x34,
+	0x3c, 0x3f, 0x59, 0x1a, 0x7e, 0x13, 0x0c, 0xe2, 0xcf, 0xa8, 0x4a, 0x2a, 0x29, 0xdb, 0x4c, 0x58,
+	0x36, 0x15, 0x48, 0xe5, 0xe1, 0xc5, 0x74, 0x1e, 0x6e, 0x7d, 0x3f, 0x07, 0xe5, 0xd1, 0x72, 0x51,
+	0x13, 0x16, 0x05, 0x3d, 0x10, 0xd2, 0x63, 0xef, 0x13, 0xcf, 0x7f, 0xe6, 0x99, 0x33, 0x0a, 0xde,
+	0x56, 0x80, 0x9d, 0x10, 0xbb, 0xa6, 0xa6, 0xe0, 0x51, 0xff, 0x83, 0x5d, 0x33, 0xa7, 0x1a, 0xb3,
+	0xef, 0xd2, 0x7e, 0x3a, 0xba, 0x04, 0x0b, 0x12, 0x8f, 0xd9, 0x16, 0xd3, 0x50, 0x74, 0x23, 0x07,
+	0xbb, 0x87, 0x5d, 0x33, 0xaf, 0xfc, 0x1c, 0x5d, 0x93, 0x59, 0x50, 0xf0, 0x98, 0x77, 0x73, 0xcd,
+	0x22, 0x5a, 0x04, 0x24, 0xf1, 0xee, 0x3b, 0x7d, 0xb3, 0x64, 0x7d, 0x9a, 0x8b, 0x19, 0x1f, 0x3b,
+	0x61, 0xfb, 0x78, 0x0f, 0x0f, 0x06, 0xce, 0x11, 0x46, 0xef, 0x25, 0xa0, 0x72, 0x53, 0x52, 0xb4,
+	0x28, 0xba, 0x1a, 0xfd, 0x2f, 0x60, 0xe6, 0x6b, 0x3c, 0xaa, 0xcc, 0x29, 0x37, 0x8a, 0x44, 0x95,
+	0xa3, 0x38, 0x12, 0xad, 0x40, 0x9e, 0x4e, 0x2a, 0xba, 0x8b, 0xb9, 0xa0, 0xc2, 0xa5, 0xcd, 0x44,
+	0xac, 0x21, 0x54, 0x84, 0xef, 0xa1, 0xeb, 0xf0, 0x5a, 0x7a, 0x46, 0xc9, 0xbd, 0xb3, 0xe0, 0xaa,
+	0x5a, 0xe4, 0xc0, 0x73, 0xfa, 0x83, 0x63, 0x3f, 0x34, 0x35, 0x74, 0x0d, 0x2e, 0xab, 0x65, 0xe8,
+	0x47, 0xcd, 0x9c, 0xf5, 0x6f, 0x0d, 0xcc, 0x83, 0xe1, 0x13, 0x02, 0xdd, 0x27, 0x38, 0x4e, 0x97,
+	0xde, 0x87, 0xc2, 0x46, 0x7b, 0x14, 0x97, 0xd5, 0xd7, 0x6e, 0xf0, 0xb0, 0x5d, 0x12, 0x5d, 0x65,
+	0x72, 0x54, 0x45, 0x51, 0x1f, 0xb4, 0x4a, 0xaf, 0xe0, 0xb0, 0xd3, 0x6b, 0xe4, 0x24, 0xec, 0x27,
+	0x92, 0x32, 0x3b, 0x92, 0x22, 0xa6, 0x26, 0x32, 0xef, 0x54, 0x4f, 0x25, 0x3b, 0x6e, 0xa2, 0xab,
+	0xe2, 0x6d, 0x19, 0x3d, 0x67, 0x25, 0x5b, 0xa0, 0x58, 0x77, 0x01, 0xf8, 0xf7, 0x09, 0x10, 0x46,
+	0xd3, 0x63, 0xe4, 0x0d, 0xd7, 0x35, 0x67, 0x08, 0x1e, 0x25, 0xba, 0x8d, 0x7b, 0xfe, 0x09, 0x36,
+	0x35, 0xeb, 0x87, 0x86, 0xb0, 0xfa, 0x18, 0x21, 0xeb, 0x09, 0x84, 0xbc, 0x9e, 0x5e, 0x7b, 0x36,
+	0x3e, 0xb2, 0x6c, 0xca, 0x9b, 0x50, 0x60, 0x6b, 0x6a, 0xe8, 0x12, 0x6c, 0x88, 0xc1, 0x66, 0x2c,
+	0x3b, 0x12, 0x41, 0x6f, 0xa7, 0x56, 0x2d, 0x76, 0xe0, 0x2c, 0x51, 0x15, 0x1c, 0x6a, 0xf9, 0xb1,
+	0x50, 0xe3, 0x95, 0x9e, 0x82, 0x50, 0xe9, 0xb1, 0xfe, 0xa5, 0x25, 0x11, 0x78, 0x0d, 0x2e, 0xcb,
+	0x2b, 0x4e, 0xe2, 0xef, 0x2a, 0x34, 0x55, 0x02, 0x6c, 0x15, 0xa6, 0x46, 0xf0, 0xa9, 0xe2, 0xf3,
+	0x49, 0x9b, 0x39, 0xf4, 0x1a, 0x5c, 0x52, 0xc9, 0x30, 0x74, 0xea, 0x99, 0x43, 0xc4, 0x34, 0x62,
+	0x53, 0x6e, 0xc0, 0x92, 0x7a, 0x9e, 0x03, 0x2e, 0x95, 0xcf, 0xfc, 0x10, 0x59, 0xba, 0x59, 0xb0,
+	0x7e, 0xae, 0x01, 0xda, 0x24, 0x47, 0x24, 0x79, 0x6f, 0xc0, 0xa1, 0xac, 0x4d, 0x04, 0xe5, 0x77,
+	0x60, 0x61, 0xa7, 0x3b, 0x1c, 0x1c, 0xa7, 0xd2, 0x8f, 0x1c, 0x73, 0xdc, 0x4a, 0x26, 0x31, 0xe9,
+	0x7b, 0xce, 0x29, 0xfb, 0x3c, 0x09, 0x91, 0x98, 0xcb, 0x49, 0xd0, 0xac, 0xbf, 0xe6, 0x61, 0x96,
+	0x63, 0x85, 0xd2, 0x49, 0xee, 0xb2, 0xe9, 0x0c, 0x12, 0xb1, 0xbf, 0x46, 0xf3, 0x76, 0x99, 0x8c,
+	0xd6, 0xe5, 0x5c, 0xea, 0x9a, 0x02, 0x80, 0x74, 0xd0, 0x55, 0x39, 0x99, 0x5a, 0x17, 0x4f, 0xe7,
+	0xf9, 0x5d, 0xd9, 0x6f, 0x7e, 0x7c, 0x65, 0x57, 0x65, 0xa4, 0x5d, 0xd5, 0xf4, 0x9e, 0xb2, 0xf9,
+	0x23, 0x0d, 0x0a, 0xa9, 0x10, 0xe7, 0x79, 0x4a, 0x7f, 0x89, 0x50, 0x44, 0x9f, 0x26, 0x14, 0x31,
+	0x78, 0x28, 0xd2, 0xfc, 0x7d, 0x2e, 0x3e, 0xdb, 0x99, 0x51, 0x08, 0xd7, 0x53, 0xa4, 0x22, 0xc1,
+	0x62, 0xf0, 0x69, 0x7a, 0x2e, 0x3e, 0xa5, 0xd3, 0xac, 0xd9, 0x22, 0x89, 0x2c, 0x32, 0x71, 0xf3,
+	0xa4, 0xdb, 0xa3, 0x36, 0x4d, 0x7e, 0xb0, 0xe7, 0x92, 0x54, 0x75, 0x1b, 0x77, 0x43, 0x47, 0xbc,
+	0x2b, 0x40, 0xb6, 0x92, 0x87, 0xee, 0xc0, 0xc5, 0xe8, 0x02, 0x28, 0xd5, 0x2d, 0x4f, 0xbb, 0x65,
+	0xb1, 0xc9, 0x7e, 0x6e, 0x76, 0xbc, 0x16, 0xc6, 0xc1, 0x6e, 0x8b, 0xe8, 0xb4, 0x40, 0x75, 0x9a,
+	0xa0, 0xc5, 0x09, 0x22, 0x0b, 0xd6, 0xc8, 0x4f, 0x45, 0x81, 0xa1, 0xa4, 0x2a, 0x30, 0x58, 0x5f,
+	0x96, 0x58, 0xf8, 0xca, 0x95, 0x19, 0x4e, 0xa1, 0xcc, 0x90, 0x45, 0x3a, 0x1c, 0x0f, 0x39, 0x09,
+	0x0f, 0x57, 0xa0, 0x3c, 0x9a, 0x68, 0x14, 0xb7, 0x72, 0x42, 0x42, 0xc9, 0x86, 0xa4, 0x64, 0x92,
+	0xf8, 0x47, 0x8a, 0x94, 0xab, 0xe0, 0xba, 0xad, 0x62, 0x91, 0xcc, 0x44, 0xd0, 0xa1, 0x7c, 0xfd,
+	0xa2, 0xdb, 0x19, 0x5c, 0x19, 0xb3, 0xc5, 0x34, 0x66, 0xe5, 0x2d, 0x28, 0x29, 0xb6, 0x20, 0x81,
+	0xeb, 0xf2, 0x34, 0xb8, 0x06, 0xa1, 0x40, 0x22, 0x64, 0xfa, 0xd1, 0x46, 0x0a, 0x47, 0xb5, 0xfa,
+	0xbc, 0xb5, 0xa5, 0xda, 0x44, 0xb5, 0xa5, 0xba, 0x22, 0xa6, 0xfd, 0xb3, 0x01, 0xc0, 0x37, 0x9c,
+	0x38, 0x75, 0x8e, 0x84, 0xa4, 0x5f, 0x4a, 0xb1, 0x22, 0x6d, 0x9b, 0x1a, 0x7a, 0x1d, 0xae, 0x2b,
+	0x59, 0x1b, 0x4f, 0xc9, 0x35, 0x2a, 0xbb, 0xf0, 0x61, 0x5e, 0x29, 0x29, 0x76, 0x78, 0xf8, 0xf0,
+	0xde, 0x69, 0x1b, 0x63, 0x37, 0x0e, 0x70, 0x25, 0x76, 0xd4, 0xd3, 0x50, 0x7d, 0xfb, 0x29, 0x8d,
+	0x7d, 0xf3, 0x24, 0xa2, 0x4b, 0xb2, 0x88, 0x9a, 0x1f, 0x7b, 0x01, 0x76, 0xda, 0xc7, 0x24, 0xdd,
+	0x34, 0x0b, 0x68, 0x09, 0xae, 0x24, 0x45, 0xf6, 0x71, 0x42, 0xa2, 0x98, 0x1e, 0xe4, 0x81, 0x3f,
+	0x48, 0x88, 0x94, 0xd2, 0x6b, 0x6c, 0x05, 0x7e, 0xe8, 0xb7, 0xfd, 0xae, 0x28, 0x56, 0x4e, 0x8f,
+	0xb4, 0xe1, 0xf6, 0x3a, 0x5e, 0x2b, 0xf0, 0x8f, 0x3b, 0x4f, 0x3a, 0x24, 0x90, 0x87, 0xf4, 0x48,
+	0xf1, 0xa5, 0x0b, 0x7d, 0x5c, 0xb2, 0xcf, 0xd4, 0x51, 0x41, 0xcb, 0x70, 0x23, 0x29, 0xb6, 0x8d,
+	0x07, 0x61, 0xf4, 0x02, 0x45, 0xfc, 0x66, 0x95, 0x44, 0x0c, 0x49, 0xc9, 0x03, 0x7f, 0x18, 0xb4,
+	0xf1, 0x37, 0x09, 0x0a, 0x8e, 0xcd, 0x1a, 0x09, 0xf3, 0x65, 0xed, 0xb9, 0x9d, 0x00, 0xb7, 0x43,
+	0xb3, 0x4e, 0x42, 0x01, 0x69, 0x59, 0x4e, 0xe0, 0xf4, 0x70, 0x88, 0x03, 0x82, 0xe3, 0x2e, 0xee,
+	0x99, 0xb3, 0x69, 0xed, 0x47, 0x40, 0x34, 0x4d, 0xb4, 0x00, 0x73, 0x49, 0x16, 0x49, 0x12, 0xe6,
+	0xac, 0x5f, 0x01, 0x94, 0xe2, 0x7c, 0x3f, 0xd3, 0x7e, 0xc7, 0x02, 0xa3, 0x1f, 0x82, 0xfd, 0x5e,
+	0x93, 0x3d, 0x6b, 0x23, 0xdd, 0x4d, 0x76, 0xa9, 0xef, 0x40, 0x61, 0xeb, 0xd8, 0xf1, 0x8e, 0x70,
+	0x14, 0x0d, 0x5e, 0x49, 0x77, 0x69, 0x39, 0xe1, 0x31, 0x93, 0xb1, 0x23, 0x59, 0xf1, 0xf8, 0x19,
+	0x93, 0x1d, 0xbf, 0x49, 0x4a, 0xb6, 0xff, 0xc9, 0x81, 0xfe, 0xc0, 0xef, 0xc7, 0xc7, 0x5d, 0xe3,
+	0xc7, 0x3d, 0x61, 0x30, 0x73, 0xb2, 0xc1, 0x94, 0x0d, 0x91, 0xae, 0x30, 0x44, 0x89, 0x1a, 0x9c,
+	0x31, 0xb6, 0x06, 0x97, 0x57, 0xd5, 0xe0, 0x76, 0xa0, 0xfe, 0xd0, 0x19, 0x84, 0x7c, 0xdf, 0x1a,
+	0x05, 0x69, 0x77, 0xd4, 0x0e, 0x41, 0xea, 0x45, 0x4c, 0x11, 0xa5, 0xc8, 0x25, 0x0e, 0x89, 0xfa,
+	0xff, 0xaf, 0xec, 0x36, 0x3f, 0x7b, 0xa5, 0xe2, 0x19, 0xf4, 0x55, 0x30, 0xe8, 0xc5, 0x2f, 0xab,
+	0x41, 0x2d, 0x28, 0x0f, 0x81, 0x4d, 0x45, 0x9a, 0xff, 0xc8, 0x01, 0x70, 0xa0, 0xbe, 0x12, 0xb3,
+	0x8f, 0x60, 0x9c, 0xe7, 0x30, 0xb6, 0xa0, 0xfa, 0xa8, 0xeb, 0x72, 0x24, 0x17, 0xe8, 0xcc, 0x12,
+	0x34, 0x12, 0x48, 0x8b, 0x6d, 0xee, 0x7b, 0x65, 0x32, 0x19, 0x6d, 0x1f, 0x3f, 0xe3, 0xa3, 0x95,
+	0xd8, 0x68, 0x22, 0x8d, 0x8c, 0x26, 0xb6, 0xc9, 0x68, 0x65, 0x36, 0x9a, 0x44, 0x26, 0xe9, 0x02,
+	0xd3, 0x9d, 0x1c, 0x26, 0x30, 0xa0, 0xa8, 0x99, 0x96, 0x0f, 0x55, 0xd1, 0x10, 0x11, 0xd3, 0x26,
+	0xb6, 0xe9, 0x0f, 0x76, 0xb5, 0x23, 0x92, 0xf9, 0x46, 0x99, 0x1a, 0xba, 0x08, 0xf3, 0x22, 0x2f,
+	0x36, 0x93, 0x39, 0x34, 0x0f, 0xb3, 0x22, 0x83, 0x18, 0x49, 0xdd, 0xfa, 0x5c, 0x23, 0xf7, 0xf5,
+	0xe1, 0xf1, 0xde, 0xe1, 0xe3, 0x38, 0x31, 0x3a, 0xef, 0x72, 0xf1, 0x0d, 0xa8, 0xef, 0x75, 0x3c,
+	0xb1, 0x04, 0xc0, 0xb2, 0x1f, 0x89, 0x4a, 0xe5, 0x9c, 0x53, 0x51, 0x4e, 0x8f, 0xe4, 0x12, 0xd4,
+	0x29, 0x4a, 0x34, 0x4d, 0x28, 0x6d, 0x84, 0x21, 0xee, 0xf5, 0x69, 0x0d, 0x95, 0x88, 0x8c, 0xda,
+	0xd6, 0x1f, 0x75, 0xa8, 0x8d, 0x16, 0x41, 0x23, 0xcc, 0x17, 0xc3, 0xab, 0x74, 0x85, 0xaa, 0xa7,
+	0xaf, 0x50, 0xd3, 0xeb, 0x33, 0x94, 0xeb, 0x6b, 0x40, 0x31, 0x9a, 0x58, 0x34, 0xe9, 0xb8, 0x49,
+	0xee, 0x3f, 0x28, 0xdc, 0xc5, 0xb7, 0x83, 0x02, 0x05, 0x7d, 0x1b, 0x2e, 0x28, 0x5c, 0x72, 0xfc,
+	0xaa, 0x72, 0x85, 0x1f, 0x1f, 0x71, 0xdd, 0xab, 0x8a, 0x2e, 0xb6, 0x72, 0x9c, 0xe6, 0x4f, 0x35,
+	0x98, 0x57, 0x30, 0x92, 0xae, 0x41, 0x1b, 0xe7, 0x1a, 0x72, 0x0a, 0xd7, 0x70, 0x15, 0x60, 0x1f,
+	0x9f, 0x86, 0x0f, 0xfc, 0x3e, 0x59, 0x36, 0xdb, 0x77, 0x81, 0x22, 0xd7, 0x90, 0x8c, 0x54, 0x0d,
+	0xc9, 0xfa, 0x42, 0x87, 0x39, 0xe1, 0xc2, 0xfd, 0x15, 0xb2, 0x41, 0xb7, 0xa0, 0xf4, 0xa8, 0xeb,
+	0xd2, 0x59, 0xd1, 0x0d, 0xad, 0x0b, 0xb7, 0x31, 0xc2, 0x8c, 0xed, 0x91, 0x14, 0xe9, 0xb1, 0x8f,
+	0x9f, 0xb1, 0x1e, 0x85, 0xf3, 0x7a, 0xc4, 0x52, 0xd9, 0x96, 0xa3, 0x78, 0x8e, 0xe5, 0x50, 0x38,
+	0xc1, 0x92, 0xd2, 0x09, 0x4a, 0x0f, 0x5f, 0xca, 0xe9, 0x87, 0x2f, 0x42, 0x30, 0x02, 0xcf, 0x17,
+	0x8c, 0x54, 0x14, 0x31, 0x7e, 0x08, 0xf5, 0x28, 0x46, 0x8a, 0xed, 0xcc, 0x8b, 0xbc, 0x51, 0xe5,
+	0x97, 0x71, 0xba, 0x78, 0x19, 0xf7, 0x91, 0x51, 0xd2, 0xcc, 0x1c, 0xaf, 0xdd, 0x5b, 0xbf, 0xd6,
+	0x60, 0x76, 0xf4, 0x59, 0x56, 0x58, 0x41, 0x77, 0xf9, 0xad, 0x07, 0x7b, 0x3e, 0xf0, 0xba, 0xb4,
+	0x21, 0x23, 0xd1, 0xa8, 0x2d, 0xdd, 0x7d, 0x34, 0xbf, 0x05, 0x55, 0x91, 0x31, 0xee, 0xbd, 0x60,
+	0x54, 0x50, 0x8c, 0x1e, 0xf3, 0xc5, 0x4d, 0x7e, 0x53, 0xa7, 0x8b, 0x37, 0x75, 0x3f, 0xc8, 0x43,
+	0x2d, 0x5a, 0x7d, 0x34, 0xdd, 0x71, 0xcf, 0x13, 0x54, 0x15, 0xf1, 0xdc, 0xe4, 0x15, 0x71, 0x7d,
+	0xaa, 0x8a, 0xb8, 0xf1, 0x1c, 0x15, 0xf1, 0xfc, 0xb4, 0x15, 0xf1, 0xc2, 0x4b, 0xa9, 0x88, 0xdf,
+	0xe5, 0xf0, 0x2a, 0x4d, 0xb5, 0xcd, 0x11, 0x17, 0x6d, 0x41, 0x65, 0xc3, 0xfd, 0xce, 0x70, 0x10,
+	0x12, 0x13, 0x49, 0x9e, 0xff, 0x26, 0x9f, 0x9a, 0x24, 0x76, 0x69, 0x95, 0x4b, 0xda, 0x62, 0x2f,
+	0x01, 0xa8, 0x90, 0x51, 0x6c, 0xad, 0xa8, 0x8b, 0xad, 0xd5, 0x71, 0xc5, 0xd6, 0x5a, 0xc6, 0x5b,
+	0xd6, 0x6d, 0x00, 0x3e, 0x01, 0xf6, 0x94, 0x14, 0x77, 0xdd, 0x08, 0x98, 0xac, 0x31, 0xfe, 0x49,
+	0x8b, 0xf5, 0x77, 0x0d, 0x6a, 0xac, 0xec, 0x13, 0x1f, 0xd7, 0xff, 0x0d, 0xce, 0x32, 0x51, 0x63,
+	0x9c, 0x87, 0x1a, 0x25, 0x12, 0xf2, 0xe7, 0x3c, 0xa2, 0x89, 0xf6, 0xa0, 0x70, 0xae, 0xb1, 0xf8,
+	0xad, 0x06, 0xb3, 0xa4, 0xf1, 0xc8, 0x6b, 0x63, 0x85, 0x91, 0xd2, 0xa6, 0x35, 0x52, 0x89, 0x57,
+	0xb0, 0xe2, 0x5e, 0xbe, 0xfc, 0x17, 0x2c, 0xd6, 0x6f, 0x0c, 0xa8, 0xf3, 0xb9, 0x53, 0xb3, 0xf4,
+	0xa1, 0x3c, 0xf5, 0x1b, 0x09, 0x3b, 0xce, 0x25, 0x33, 0xf0, 0x7f, 0x05, 0xca, 0x5b, 0x7e, 0xaf,
+	0xdf, 0xc5, 0x21, 0x76, 0x23, 0xe3, 0xc5, 0x09, 0xcd, 0xcf, 0xf5, 0xe9, 0xac, 0xe0, 0x8b, 0x57,
+	0x9c, 0x8d, 0xb1, 0x81, 0x5a, 0x3e, 0x1d, 0xa8, 0xbd, 0x94, 0x9a, 0x74, 0x32, 0xdb, 0x2d, 0x8d,
+	0xfd, 0x3b, 0x8f, 0xf2, 0x04, 0x7f, 0xe7, 0x01, 0x69, 0xc7, 0x9b, 0xca, 0x4f, 0x2b, 0x13, 0xe5,
+	0xa7, 0xd5, 0x89, 0xf2, 0xd3, 0x9a, 0x22, 0x3f, 0x5d, 0xd9, 0x14, 0xb4, 0x83, 0xe6, 0xa0, 0x36,
+	0x6a, 0xec, 0x6e, 0xed, 0xb5, 0xcc, 0x19, 0x64, 0x42, 0x75, 0x44, 0x3a, 0xdc, 0x6a, 0x99, 0x5a,
+	0x82, 0xf2, 0x78, 0xbb, 0x65, 0xe6, 0x56, 0x6e, 0xc7, 0x6f, 0xbf, 0xe8, 0xf3, 0x22, 0x04, 0x75,
+	0xde, 0x22, 0xbf, 0xcc, 0x19, 0x92, 0x78, 0x70, 0xda, 0x61, 0xe0, 0xb4, 0xb1, 0xa9, 0xad, 0x3c,
+	0x8d, 0xb7, 0x8e, 0x05, 0x3d, 0x8b, 0x80, 0x84, 0x26, 0xbf, 0xf0, 0x9b, 0x83, 0x9a, 0x48, 0xef,
+	0xb3, 0x04, 0x47, 0x20, 0xc5, 0x6f, 0x70, 0x58, 0x82, 0x23, 0x32, 0xc8, 0x00, 0xfa, 0xca, 0x8f,
+	0x49, 0x11, 0x54, 0x7a, 0x90, 0x48, 0xcb, 0x5b, 0x12, 0x8d, 0xfc, 0xda, 0xc7, 0xcf, 0xf0, 0x20,
+	0x34, 0x67, 0xb2, 0xf8, 0x8f, 0xba, 0x2e, 0xe1, 0x6b, 0xb4, 0xec, 0x28, 0xf1, 0xe9, 0xc3, 0x45,
+	0x33, 0xa7, 0xec, 0xda, 0x19, 0xb4, 0x7d, 0xcf, 0x23, 0x77, 0x5d, 0xfa, 0xca, 0x9f, 0x34, 0x98,
+	0x4b, 0xfd, 0x19, 0x17, 0xba, 0x02, 0x8d, 0x14, 0x91, 0x2b, 0x41, 0xc5, 0x8d, 0x8b, 0xe4, 0x9a,
+	0x92, 0x1b, 0x97, 0xd7, 0xe9, 0x7c, 0x52, 0xdc, 0xd1, 0x29, 0x36, 0x75, 0x74, 0x19, 0x2e, 0xa6,
+	0xf8, 0x3b, 0x4e, 0xa7, 0x4b, 0xeb, 0x6f, 0x5f, 0x81, 0x6b, 0xe9, 0xa1, 0x71, 0x70, 0x82, 0x83,
+	0x83, 0xe3, 0x61, 0xe8, 0x92, 0xd9, 0xe5, 0xd7, 0xfe, 0x59, 0x82, 0x02, 0xdb, 0x5f, 0x74, 0x07,
+	0xf2, 0xd4, 0x2a, 0xa2, 0x05, 0xa5, 0x95, 0x6c, 0x2e, 0xaa, 0xbd, 0xa7, 0x35, 0x83, 0x56, 0xc0,
+	0x20, 0x73, 0x46, 0x73, 0xf2, 0xeb, 0x88, 0xed, 0x66, 0x6d, 0x44, 0xa2, 0x7f, 0x47, 0x37, 0x83,
+	0x6e, 0x43, 0xed, 0x3e, 0x0e, 0x85, 0x07, 0xa1, 0x49, 0x89, 0xa6, 0xaa, 0x70, 0x6f, 0xcd, 0xa0,
+	0x75, 0xa1, 0x1b, 0x7d, 0x6e, 0xa1, 0xf8, 0x96, 0xea, 0x71, 0x86, 0x35, 0x83, 0x36, 0xa0, 0x7e,
+	0x1f, 0x87, 0x03, 0xa1, 0x26, 0x9b, 0x51, 0x2d, 0x6c, 0xaa, 0x8a, 0xb9, 0xd6, 0xcc, 0x2d, 0x2d,
+	0x1e, 0x42, 0xa8, 0x82, 0x8c, 0x1f, 0x82, 0x0b, 0xd3, 0x21, 0x3e, 0x80, 0x2a, 0x19, 0x62, 0x74,
+	0xa7, 0x99, 0x35, 0xc0, 0x5c, 0xea, 0x62, 0x87, 0x76, 0xdf, 0x86, 0x59, 0x8a, 0xc4, 0x13, 0x1c,
+	0xc4, 0x49, 0xe8, 0xc5, 0x74, 0x1a, 0x29, 0x6f, 0x54, 0x22, 0xbf, 0xb4, 0x66, 0xd0, 0x47, 0x60,
+	0xd2, 0xf7, 0x06, 0x89, 0xc3, 0x9b, 0x31, 0x91, 0xa6, 0x2a, 0xd3, 0x61, 0xe9, 0x4b, 0xa4, 0x13,
+	0xd8, 0x70, 0xdd, 0xd8, 0xdf, 0x5c, 0x4c, 0xc7, 0x67, 0x6c, 0x98, 0x46, 0x56, 0xe0, 0x66, 0xcd,
+	0xa0, 0x6d, 0xa8, 0xb1, 0x8a, 0xff, 0x0b, 0x8d, 0xf2, 0x01, 0x54, 0x59, 0xb0, 0x13, 0xe1, 0x98,
+	0x2f, 0x28, 0x11, 0x03, 0x65, 0xc1, 0xe3, 0x4d, 0xc8, 0xd3, 0x67, 0x30, 0x13, 0xa1, 0xf7, 0x2d,
+	0x56, 0x53, 0xec, 0x4d, 0x26, 0x7d, 0x17, 0x4a, 0xb1, 0xb3, 0x46, 0x0d, 0x85, 0xff, 0x66, 0xf3,
+	0xba, 0x98, 0xe1, 0xd9, 0xad, 0x19, 0xd4, 0x82, 0xf9, 0x24, 0xee, 0x58, 0x7d, 0xf9, 0xf2, 0xa8,
+	0x47, 0xba, 0x34, 0x2e, 0xa8, 0x4a, 0xea, 0x46, 0x77, 0xed, 0x3e, 0x94, 0x47, 0xe5, 0x76, 0x74,
+	0x29, 0xf3, 0xf9, 0x48, 0xf3, 0x52, 0xe6, 0xeb, 0x0a, 0x6b, 0x66, 0x59, 0xbb, 0xa5, 0xa1, 0x0f,
+	0xa1, 0x4a, 0xa1, 0x14, 0x3f, 0xa9, 0x91, 0x8e, 0xf1, 0xe5, 0x73, 0xde, 0xef, 0x90, 0x89, 0x3c,
+	0x29, 0xd0, 0xbf, 0xb4, 0x7d, 0xfb, 0xbf, 0x03, 0x00, 0x3a, 0x00, 0x71, 0x1e, 0x7b, 0x3b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -769,12 +4305,24 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type PingerClient interface {
-	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*PingerID, error)
+	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
 	Stop(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*Null, error)
 	GetPingerList(ctx context.Context, in *Null, opts ...grpc.CallOption) (*PingerList, error)
 	GetPingerInfo(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*PingerInfo, error)
-	GetsStatistics(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (Pinger_GetsStatisticsClient, error)
-	GetsIcmpResult(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (Pinger_GetsIcmpResultClient, error)
+	GetsStatistics(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Pinger_GetsStatisticsClient, error)
+	GetsIcmpResult(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Pinger_GetsIcmpResultClient, error)
+	GetsHopTable(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Pinger_GetsHopTableClient, error)
+	DiscoverPathMTU(ctx context.Context, in *PathMTURequest, opts ...grpc.CallOption) (*PathMTUResult, error)
+	WatchTargetState(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Pinger_WatchTargetStateClient, error)
+	AddTargets(ctx context.Context, in *TargetsRequest, opts ...grpc.CallOption) (*TargetsResponse, error)
+	RemoveTargets(ctx context.Context, in *TargetsRequest, opts ...grpc.CallOption) (*TargetsResponse, error)
+	UpdatePinger(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*PingerInfo, error)
+	Pause(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*Null, error)
+	Resume(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*Null, error)
+	PingOnce(ctx context.Context, in *PingOnceRequest, opts ...grpc.CallOption) (*PingOnceResult, error)
+	GetsIcmpResultBatch(ctx context.Context, in *BatchStreamRequest, opts ...grpc.CallOption) (Pinger_GetsIcmpResultBatchClient, error)
+	Subscribe(ctx context.Context, opts ...grpc.CallOption) (Pinger_SubscribeClient, error)
+	WatchPingers(ctx context.Context, in *Null, opts ...grpc.CallOption) (Pinger_WatchPingersClient, error)
 }
 
 type pingerClient struct {
@@ -785,48 +4333,239 @@ func NewPingerClient(cc grpc.ClientConnInterface) PingerClient {
 	return &pingerClient{cc}
 }
 
-func (c *pingerClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*PingerID, error) {
-	out := new(PingerID)
-	err := c.cc.Invoke(ctx, "/uPinger.Pinger/Start", in, out, opts...)
+func (c *pingerClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
+	out := new(StartResponse)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/Start", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingerClient) Stop(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/Stop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingerClient) GetPingerList(ctx context.Context, in *Null, opts ...grpc.CallOption) (*PingerList, error) {
+	out := new(PingerList)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/GetPingerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingerClient) GetPingerInfo(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*PingerInfo, error) {
+	out := new(PingerInfo)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/GetPingerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingerClient) GetsStatistics(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Pinger_GetsStatisticsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[0], "/uPinger.Pinger/GetsStatistics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pingerGetsStatisticsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pinger_GetsStatisticsClient interface {
+	Recv() (*Statistics, error)
+	grpc.ClientStream
+}
+
+type pingerGetsStatisticsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pingerGetsStatisticsClient) Recv() (*Statistics, error) {
+	m := new(Statistics)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pingerClient) GetsIcmpResult(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Pinger_GetsIcmpResultClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[1], "/uPinger.Pinger/GetsIcmpResult", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pingerGetsIcmpResultClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pinger_GetsIcmpResultClient interface {
+	Recv() (*IcmpResult, error)
+	grpc.ClientStream
+}
+
+type pingerGetsIcmpResultClient struct {
+	grpc.ClientStream
+}
+
+func (x *pingerGetsIcmpResultClient) Recv() (*IcmpResult, error) {
+	m := new(IcmpResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pingerClient) GetsHopTable(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Pinger_GetsHopTableClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[2], "/uPinger.Pinger/GetsHopTable", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pingerGetsHopTableClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pinger_GetsHopTableClient interface {
+	Recv() (*HopTable, error)
+	grpc.ClientStream
+}
+
+type pingerGetsHopTableClient struct {
+	grpc.ClientStream
+}
+
+func (x *pingerGetsHopTableClient) Recv() (*HopTable, error) {
+	m := new(HopTable)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pingerClient) DiscoverPathMTU(ctx context.Context, in *PathMTURequest, opts ...grpc.CallOption) (*PathMTUResult, error) {
+	out := new(PathMTUResult)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/DiscoverPathMTU", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingerClient) WatchTargetState(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Pinger_WatchTargetStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[3], "/uPinger.Pinger/WatchTargetState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pingerWatchTargetStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pinger_WatchTargetStateClient interface {
+	Recv() (*TargetStateChange, error)
+	grpc.ClientStream
+}
+
+type pingerWatchTargetStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *pingerWatchTargetStateClient) Recv() (*TargetStateChange, error) {
+	m := new(TargetStateChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pingerClient) AddTargets(ctx context.Context, in *TargetsRequest, opts ...grpc.CallOption) (*TargetsResponse, error) {
+	out := new(TargetsResponse)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/AddTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingerClient) RemoveTargets(ctx context.Context, in *TargetsRequest, opts ...grpc.CallOption) (*TargetsResponse, error) {
+	out := new(TargetsResponse)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/RemoveTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingerClient) UpdatePinger(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*PingerInfo, error) {
+	out := new(PingerInfo)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/UpdatePinger", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pingerClient) Stop(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*Null, error) {
+func (c *pingerClient) Pause(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*Null, error) {
 	out := new(Null)
-	err := c.cc.Invoke(ctx, "/uPinger.Pinger/Stop", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/Pause", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pingerClient) GetPingerList(ctx context.Context, in *Null, opts ...grpc.CallOption) (*PingerList, error) {
-	out := new(PingerList)
-	err := c.cc.Invoke(ctx, "/uPinger.Pinger/GetPingerList", in, out, opts...)
+func (c *pingerClient) Resume(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/Resume", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pingerClient) GetPingerInfo(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (*PingerInfo, error) {
-	out := new(PingerInfo)
-	err := c.cc.Invoke(ctx, "/uPinger.Pinger/GetPingerInfo", in, out, opts...)
+func (c *pingerClient) PingOnce(ctx context.Context, in *PingOnceRequest, opts ...grpc.CallOption) (*PingOnceResult, error) {
+	out := new(PingOnceResult)
+	err := c.cc.Invoke(ctx, "/uPinger.Pinger/PingOnce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pingerClient) GetsStatistics(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (Pinger_GetsStatisticsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[0], "/uPinger.Pinger/GetsStatistics", opts...)
+func (c *pingerClient) GetsIcmpResultBatch(ctx context.Context, in *BatchStreamRequest, opts ...grpc.CallOption) (Pinger_GetsIcmpResultBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[4], "/uPinger.Pinger/GetsIcmpResultBatch", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &pingerGetsStatisticsClient{stream}
+	x := &pingerGetsIcmpResultBatchClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -836,29 +4575,60 @@ func (c *pingerClient) GetsStatistics(ctx context.Context, in *PingerID, opts ..
 	return x, nil
 }
 
-type Pinger_GetsStatisticsClient interface {
-	Recv() (*Statistics, error)
+type Pinger_GetsIcmpResultBatchClient interface {
+	Recv() (*IcmpResultBatch, error)
 	grpc.ClientStream
 }
 
-type pingerGetsStatisticsClient struct {
+type pingerGetsIcmpResultBatchClient struct {
 	grpc.ClientStream
 }
 
-func (x *pingerGetsStatisticsClient) Recv() (*Statistics, error) {
-	m := new(Statistics)
+func (x *pingerGetsIcmpResultBatchClient) Recv() (*IcmpResultBatch, error) {
+	m := new(IcmpResultBatch)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *pingerClient) GetsIcmpResult(ctx context.Context, in *PingerID, opts ...grpc.CallOption) (Pinger_GetsIcmpResultClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[1], "/uPinger.Pinger/GetsIcmpResult", opts...)
+func (c *pingerClient) Subscribe(ctx context.Context, opts ...grpc.CallOption) (Pinger_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[5], "/uPinger.Pinger/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &pingerGetsIcmpResultClient{stream}
+	x := &pingerSubscribeClient{stream}
+	return x, nil
+}
+
+type Pinger_SubscribeClient interface {
+	Send(*SubscribeRequest) error
+	Recv() (*SubscribeMessage, error)
+	grpc.ClientStream
+}
+
+type pingerSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *pingerSubscribeClient) Send(m *SubscribeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pingerSubscribeClient) Recv() (*SubscribeMessage, error) {
+	m := new(SubscribeMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pingerClient) WatchPingers(ctx context.Context, in *Null, opts ...grpc.CallOption) (Pinger_WatchPingersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pinger_serviceDesc.Streams[6], "/uPinger.Pinger/WatchPingers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pingerWatchPingersClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -868,17 +4638,17 @@ func (c *pingerClient) GetsIcmpResult(ctx context.Context, in *PingerID, opts ..
 	return x, nil
 }
 
-type Pinger_GetsIcmpResultClient interface {
-	Recv() (*IcmpResult, error)
+type Pinger_WatchPingersClient interface {
+	Recv() (*PingerWatchMessage, error)
 	grpc.ClientStream
 }
 
-type pingerGetsIcmpResultClient struct {
+type pingerWatchPingersClient struct {
 	grpc.ClientStream
 }
 
-func (x *pingerGetsIcmpResultClient) Recv() (*IcmpResult, error) {
-	m := new(IcmpResult)
+func (x *pingerWatchPingersClient) Recv() (*PingerWatchMessage, error) {
+	m := new(PingerWatchMessage)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -887,19 +4657,31 @@ func (x *pingerGetsIcmpResultClient) Recv() (*IcmpResult, error) {
 
 // PingerServer is the server API for Pinger service.
 type PingerServer interface {
-	Start(context.Context, *StartRequest) (*PingerID, error)
+	Start(context.Context, *StartRequest) (*StartResponse, error)
 	Stop(context.Context, *PingerID) (*Null, error)
 	GetPingerList(context.Context, *Null) (*PingerList, error)
 	GetPingerInfo(context.Context, *PingerID) (*PingerInfo, error)
-	GetsStatistics(*PingerID, Pinger_GetsStatisticsServer) error
-	GetsIcmpResult(*PingerID, Pinger_GetsIcmpResultServer) error
+	GetsStatistics(*StreamRequest, Pinger_GetsStatisticsServer) error
+	GetsIcmpResult(*StreamRequest, Pinger_GetsIcmpResultServer) error
+	GetsHopTable(*StreamRequest, Pinger_GetsHopTableServer) error
+	DiscoverPathMTU(context.Context, *PathMTURequest) (*PathMTUResult, error)
+	WatchTargetState(*StreamRequest, Pinger_WatchTargetStateServer) error
+	AddTargets(context.Context, *TargetsRequest) (*TargetsResponse, error)
+	RemoveTargets(context.Context, *TargetsRequest) (*TargetsResponse, error)
+	UpdatePinger(context.Context, *UpdateRequest) (*PingerInfo, error)
+	Pause(context.Context, *PingerID) (*Null, error)
+	Resume(context.Context, *PingerID) (*Null, error)
+	PingOnce(context.Context, *PingOnceRequest) (*PingOnceResult, error)
+	GetsIcmpResultBatch(*BatchStreamRequest, Pinger_GetsIcmpResultBatchServer) error
+	Subscribe(Pinger_SubscribeServer) error
+	WatchPingers(*Null, Pinger_WatchPingersServer) error
 }
 
 // UnimplementedPingerServer can be embedded to have forward compatible implementations.
 type UnimplementedPingerServer struct {
 }
 
-func (*UnimplementedPingerServer) Start(ctx context.Context, req *StartRequest) (*PingerID, error) {
+func (*UnimplementedPingerServer) Start(ctx context.Context, req *StartRequest) (*StartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
 }
 func (*UnimplementedPingerServer) Stop(ctx context.Context, req *PingerID) (*Null, error) {
@@ -911,12 +4693,48 @@ func (*UnimplementedPingerServer) GetPingerList(ctx context.Context, req *Null)
 func (*UnimplementedPingerServer) GetPingerInfo(ctx context.Context, req *PingerID) (*PingerInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPingerInfo not implemented")
 }
-func (*UnimplementedPingerServer) GetsStatistics(req *PingerID, srv Pinger_GetsStatisticsServer) error {
+func (*UnimplementedPingerServer) GetsStatistics(req *StreamRequest, srv Pinger_GetsStatisticsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetsStatistics not implemented")
 }
-func (*UnimplementedPingerServer) GetsIcmpResult(req *PingerID, srv Pinger_GetsIcmpResultServer) error {
+func (*UnimplementedPingerServer) GetsIcmpResult(req *StreamRequest, srv Pinger_GetsIcmpResultServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetsIcmpResult not implemented")
 }
+func (*UnimplementedPingerServer) GetsHopTable(req *StreamRequest, srv Pinger_GetsHopTableServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetsHopTable not implemented")
+}
+func (*UnimplementedPingerServer) DiscoverPathMTU(ctx context.Context, req *PathMTURequest) (*PathMTUResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverPathMTU not implemented")
+}
+func (*UnimplementedPingerServer) WatchTargetState(req *StreamRequest, srv Pinger_WatchTargetStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTargetState not implemented")
+}
+func (*UnimplementedPingerServer) AddTargets(ctx context.Context, req *TargetsRequest) (*TargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTargets not implemented")
+}
+func (*UnimplementedPingerServer) RemoveTargets(ctx context.Context, req *TargetsRequest) (*TargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargets not implemented")
+}
+func (*UnimplementedPingerServer) UpdatePinger(ctx context.Context, req *UpdateRequest) (*PingerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePinger not implemented")
+}
+func (*UnimplementedPingerServer) Pause(ctx context.Context, req *PingerID) (*Null, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedPingerServer) Resume(ctx context.Context, req *PingerID) (*Null, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (*UnimplementedPingerServer) PingOnce(ctx context.Context, req *PingOnceRequest) (*PingOnceResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PingOnce not implemented")
+}
+func (*UnimplementedPingerServer) GetsIcmpResultBatch(req *BatchStreamRequest, srv Pinger_GetsIcmpResultBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetsIcmpResultBatch not implemented")
+}
+func (*UnimplementedPingerServer) Subscribe(srv Pinger_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedPingerServer) WatchPingers(req *Null, srv Pinger_WatchPingersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPingers not implemented")
+}
 
 func RegisterPingerServer(s *grpc.Server, srv PingerServer) {
 	s.RegisterService(&_Pinger_serviceDesc, srv)
@@ -995,7 +4813,7 @@ func _Pinger_GetPingerInfo_Handler(srv interface{}, ctx context.Context, dec fun
 }
 
 func _Pinger_GetsStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PingerID)
+	m := new(StreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -1016,7 +4834,7 @@ func (x *pingerGetsStatisticsServer) Send(m *Statistics) error {
 }
 
 func _Pinger_GetsIcmpResult_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PingerID)
+	m := new(StreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -1036,6 +4854,242 @@ func (x *pingerGetsIcmpResultServer) Send(m *IcmpResult) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Pinger_GetsHopTable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PingerServer).GetsHopTable(m, &pingerGetsHopTableServer{stream})
+}
+
+type Pinger_GetsHopTableServer interface {
+	Send(*HopTable) error
+	grpc.ServerStream
+}
+
+type pingerGetsHopTableServer struct {
+	grpc.ServerStream
+}
+
+func (x *pingerGetsHopTableServer) Send(m *HopTable) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Pinger_DiscoverPathMTU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathMTURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingerServer).DiscoverPathMTU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uPinger.Pinger/DiscoverPathMTU",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingerServer).DiscoverPathMTU(ctx, req.(*PathMTURequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinger_WatchTargetState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PingerServer).WatchTargetState(m, &pingerWatchTargetStateServer{stream})
+}
+
+type Pinger_WatchTargetStateServer interface {
+	Send(*TargetStateChange) error
+	grpc.ServerStream
+}
+
+type pingerWatchTargetStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *pingerWatchTargetStateServer) Send(m *TargetStateChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Pinger_AddTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingerServer).AddTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uPinger.Pinger/AddTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingerServer).AddTargets(ctx, req.(*TargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinger_RemoveTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingerServer).RemoveTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uPinger.Pinger/RemoveTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingerServer).RemoveTargets(ctx, req.(*TargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinger_UpdatePinger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingerServer).UpdatePinger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uPinger.Pinger/UpdatePinger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingerServer).UpdatePinger(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinger_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingerID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingerServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uPinger.Pinger/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingerServer).Pause(ctx, req.(*PingerID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinger_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingerID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingerServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uPinger.Pinger/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingerServer).Resume(ctx, req.(*PingerID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinger_PingOnce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingOnceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingerServer).PingOnce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uPinger.Pinger/PingOnce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingerServer).PingOnce(ctx, req.(*PingOnceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pinger_GetsIcmpResultBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PingerServer).GetsIcmpResultBatch(m, &pingerGetsIcmpResultBatchServer{stream})
+}
+
+type Pinger_GetsIcmpResultBatchServer interface {
+	Send(*IcmpResultBatch) error
+	grpc.ServerStream
+}
+
+type pingerGetsIcmpResultBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *pingerGetsIcmpResultBatchServer) Send(m *IcmpResultBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Pinger_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PingerServer).Subscribe(&pingerSubscribeServer{stream})
+}
+
+type Pinger_SubscribeServer interface {
+	Send(*SubscribeMessage) error
+	Recv() (*SubscribeRequest, error)
+	grpc.ServerStream
+}
+
+type pingerSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *pingerSubscribeServer) Send(m *SubscribeMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pingerSubscribeServer) Recv() (*SubscribeRequest, error) {
+	m := new(SubscribeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Pinger_WatchPingers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Null)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PingerServer).WatchPingers(m, &pingerWatchPingersServer{stream})
+}
+
+type Pinger_WatchPingersServer interface {
+	Send(*PingerWatchMessage) error
+	grpc.ServerStream
+}
+
+type pingerWatchPingersServer struct {
+	grpc.ServerStream
+}
+
+func (x *pingerWatchPingersServer) Send(m *PingerWatchMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Pinger_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "uPinger.Pinger",
 	HandlerType: (*PingerServer)(nil),
@@ -1056,6 +5110,34 @@ var _Pinger_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPingerInfo",
 			Handler:    _Pinger_GetPingerInfo_Handler,
 		},
+		{
+			MethodName: "DiscoverPathMTU",
+			Handler:    _Pinger_DiscoverPathMTU_Handler,
+		},
+		{
+			MethodName: "AddTargets",
+			Handler:    _Pinger_AddTargets_Handler,
+		},
+		{
+			MethodName: "RemoveTargets",
+			Handler:    _Pinger_RemoveTargets_Handler,
+		},
+		{
+			MethodName: "UpdatePinger",
+			Handler:    _Pinger_UpdatePinger_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Pinger_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Pinger_Resume_Handler,
+		},
+		{
+			MethodName: "PingOnce",
+			Handler:    _Pinger_PingOnce_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1068,6 +5150,32 @@ var _Pinger_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Pinger_GetsIcmpResult_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetsHopTable",
+			Handler:       _Pinger_GetsHopTable_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTargetState",
+			Handler:       _Pinger_WatchTargetState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetsIcmpResultBatch",
+			Handler:       _Pinger_GetsIcmpResultBatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Pinger_Subscribe_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchPingers",
+			Handler:       _Pinger_WatchPingers_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pingGrpc.proto",
 }
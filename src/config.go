@@ -31,6 +31,9 @@ type Config struct {
 	//ICMPを撃つアドレス(基本0.0.0.0でいいかと)
 	ICMPSourceIPAddress string `json:"ICMPSourceIPAddress"`
 
+	//ICMPv6を撃つアドレス(基本::でいいかと)
+	ICMPv6SourceIPAddress string `json:"ICMPv6SourceIPAddress"`
+
 	//リクエストの値を制限
 	Limit tValueLimit `json:"Limit"`
 
@@ -61,6 +64,57 @@ type tValueLimit struct {
 
 	//pingの統計を集計するインターバル
 	StatisticsIntervalSec tValueRange `json:"StatisticsIntervalSec"`
+
+	//traceモードで探索する最大ホップ数
+	MaxHops tValueRange `json:"MaxHops"`
+
+	//送信するパケットのTTL(0はOSのデフォルト)
+	TTL tValueRange `json:"TTL"`
+
+	//送信するパケットのTOS(DSCPは上位6bit)
+	TOS tValueRange `json:"TOS"`
+
+	//送信するパケットのペイロードのサイズ(バイト)
+	PayloadSize tValueRange `json:"PayloadSize"`
+
+	//Path MTU探索で一つのサイズを試す回数
+	PathMTUAttempts tValueRange `json:"PathMTUAttempts"`
+
+	//時間窓での統計の窓の長さ
+	StatisticsWindowSec tValueRange `json:"StatisticsWindowSec"`
+
+	//時間窓での統計の窓の数
+	StatisticsWindowsNum tValueRange `json:"StatisticsWindowsNum"`
+
+	//何回連続で失敗したらdownとするか
+	DownLossCount tValueRange `json:"DownLossCount"`
+
+	//down後、何回連続で成功したらupとするか
+	UpSuccessCount tValueRange `json:"UpSuccessCount"`
+
+	//状態が変わった後、次に変わるまで最低限待つ時間(フラップ抑制)
+	HoldDownMillisec tValueRange `json:"HoldDownMillisec"`
+
+	//PingOnceで一つの対象へ撃つpingの回数
+	PingOnceCount tValueRange `json:"PingOnceCount"`
+
+	//一つの対象へ撃つpingの回数(0は無制限)
+	Count tValueRange `json:"Count"`
+
+	//途中から購読したクライアントに再送するために保持する結果と統計の数
+	ReplayBufferSize tValueRange `json:"ReplayBufferSize"`
+
+	//送信を待つ方針のストリームで、遅いクライアントを待つ時間
+	StreamBlockTimeoutMillisec tValueRange `json:"StreamBlockTimeoutMillisec"`
+
+	//まとめて送る結果ストリームで、まとめる間隔
+	BatchFlushIntervalMillisec tValueRange `json:"BatchFlushIntervalMillisec"`
+
+	//まとめて送る結果ストリームで、一つのメッセージに入れる結果の数
+	BatchMaxSize tValueRange `json:"BatchMaxSize"`
+
+	//一つのpingerに登録できる対象の数(Maxが0なら上限なし)
+	TargetsNum tValueRange `json:"TargetsNum"`
 }
 
 //値の下限値と上限値
@@ -85,6 +139,7 @@ func DefaultConfig() Config {
 		ServerCertificatePath: "server.crt",
 		ServerPrivateKeyPath:  "server.pem",
 		ICMPSourceIPAddress:   "0.0.0.0",
+		ICMPv6SourceIPAddress: "::",
 		Limit: tValueLimit{
 			StopPingerSec: tValueRange{
 				Min: 0,
@@ -106,6 +161,74 @@ func DefaultConfig() Config {
 				Min: 1,
 				Max: 3600,
 			},
+			MaxHops: tValueRange{
+				Min: 1,
+				Max: 64,
+			},
+			TTL: tValueRange{
+				Min: 1,
+				Max: 255,
+			},
+			TOS: tValueRange{
+				Min: 0,
+				Max: 255,
+			},
+			PayloadSize: tValueRange{
+				Min: 0,
+				Max: 9000,
+			},
+			PathMTUAttempts: tValueRange{
+				Min: 1,
+				Max: 5,
+			},
+			StatisticsWindowSec: tValueRange{
+				Min: 10,
+				Max: 3600,
+			},
+			StatisticsWindowsNum: tValueRange{
+				Min: 0,
+				Max: 8,
+			},
+			DownLossCount: tValueRange{
+				Min: 1,
+				Max: 1000,
+			},
+			UpSuccessCount: tValueRange{
+				Min: 1,
+				Max: 1000,
+			},
+			HoldDownMillisec: tValueRange{
+				Min: 0,
+				Max: 60 * 60 * 1000,
+			},
+			PingOnceCount: tValueRange{
+				Min: 1,
+				Max: 100,
+			},
+			Count: tValueRange{
+				Min: 0,
+				Max: 1000000,
+			},
+			ReplayBufferSize: tValueRange{
+				Min: 100,
+				Max: 10000,
+			},
+			StreamBlockTimeoutMillisec: tValueRange{
+				Min: 100,
+				Max: 5000,
+			},
+			BatchFlushIntervalMillisec: tValueRange{
+				Min: 100,
+				Max: 10000,
+			},
+			BatchMaxSize: tValueRange{
+				Min: 100,
+				Max: 10000,
+			},
+			TargetsNum: tValueRange{
+				Min: 1,
+				Max: 0,
+			},
 		},
 		GrpcStreamBuffer: 5,
 	}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/umenosuke/labelinglog"
 	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
 )
@@ -12,16 +13,38 @@ type grpcServer struct {
 }
 
 // Start a
-func (thisServer *grpcServer) Start(ctx context.Context, req *pb.StartRequest) (*pb.PingerID, error) {
+func (thisServer *grpcServer) Start(ctx context.Context, req *pb.StartRequest) (*pb.StartResponse, error) {
 	logger.Log(labelinglog.FlgInfo, "Start req : "+req.String())
-	return thisServer.pingServ.pingerStartReq(req), nil
+	return thisServer.pingServ.pingerStartReq(req)
 }
 
 // Stop a
 func (thisServer *grpcServer) Stop(ctx context.Context, id *pb.PingerID) (*pb.Null, error) {
 	logger.Log(labelinglog.FlgInfo, "Stop id : "+id.String())
 
-	thisServer.pingServ.pingerStop(uint16(id.GetPingerID()))
+	if err := thisServer.pingServ.pingerStop(id.GetHandle(), peerName(ctx)); err != nil {
+		return nil, err
+	}
+	return &pb.Null{}, nil
+}
+
+// Pause a
+func (thisServer *grpcServer) Pause(ctx context.Context, id *pb.PingerID) (*pb.Null, error) {
+	logger.Log(labelinglog.FlgInfo, "Pause id : "+id.String())
+
+	if err := thisServer.pingServ.pingerPause(id.GetHandle()); err != nil {
+		return nil, err
+	}
+	return &pb.Null{}, nil
+}
+
+// Resume a
+func (thisServer *grpcServer) Resume(ctx context.Context, id *pb.PingerID) (*pb.Null, error) {
+	logger.Log(labelinglog.FlgInfo, "Resume id : "+id.String())
+
+	if err := thisServer.pingServ.pingerResume(id.GetHandle()); err != nil {
+		return nil, err
+	}
 	return &pb.Null{}, nil
 }
 
@@ -36,33 +59,122 @@ func (thisServer *grpcServer) GetPingerList(ctx context.Context, null *pb.Null)
 func (thisServer *grpcServer) GetPingerInfo(ctx context.Context, id *pb.PingerID) (*pb.PingerInfo, error) {
 	logger.Log(labelinglog.FlgInfo, "GetPingerInfo id : "+id.String())
 
-	return thisServer.pingServ.info(uint16(id.GetPingerID())), nil
+	return thisServer.pingServ.info(id.GetHandle())
 }
 
 // GetsStatistics a
-func (thisServer *grpcServer) GetsStatistics(id *pb.PingerID, server pb.Pinger_GetsStatisticsServer) error {
-	logger.Log(labelinglog.FlgInfo, "GetsStatistics id : "+id.String())
-
-	ch := thisServer.pingServ.getsStatistics(uint16(id.GetPingerID()))
-	for result := range ch {
-		if err := server.Send(result); err != nil {
-			return err
-		}
+func (thisServer *grpcServer) GetsStatistics(req *pb.StreamRequest, server pb.Pinger_GetsStatisticsServer) error {
+	logger.Log(labelinglog.FlgInfo, "GetsStatistics req : "+req.String())
+
+	listener, err := thisServer.pingServ.getsStatistics(req)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return listener.sendAll(server.Context(), func(message proto.Message) error {
+		return server.Send(message.(*pb.Statistics))
+	})
 }
 
 // GetsIcmpResult a
-func (thisServer *grpcServer) GetsIcmpResult(id *pb.PingerID, server pb.Pinger_GetsIcmpResultServer) error {
-	logger.Log(labelinglog.FlgInfo, "GetsIcmpResult id : "+id.String())
-
-	ch := thisServer.pingServ.getsIcmpResult(uint16(id.GetPingerID()))
-	for result := range ch {
-		if err := server.Send(result); err != nil {
-			return err
-		}
+func (thisServer *grpcServer) GetsIcmpResult(req *pb.StreamRequest, server pb.Pinger_GetsIcmpResultServer) error {
+	logger.Log(labelinglog.FlgInfo, "GetsIcmpResult req : "+req.String())
+
+	listener, err := thisServer.pingServ.getsIcmpResult(req)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return listener.sendAll(server.Context(), func(message proto.Message) error {
+		return server.Send(message.(*pb.IcmpResult))
+	})
+}
+
+// GetsHopTable a
+func (thisServer *grpcServer) GetsHopTable(req *pb.StreamRequest, server pb.Pinger_GetsHopTableServer) error {
+	logger.Log(labelinglog.FlgInfo, "GetsHopTable req : "+req.String())
+
+	listener, err := thisServer.pingServ.getsHopTable(req)
+	if err != nil {
+		return err
+	}
+
+	return listener.sendAll(server.Context(), func(message proto.Message) error {
+		return server.Send(message.(*pb.HopTable))
+	})
+}
+
+// GetsIcmpResultBatch a
+func (thisServer *grpcServer) GetsIcmpResultBatch(req *pb.BatchStreamRequest, server pb.Pinger_GetsIcmpResultBatchServer) error {
+	logger.Log(labelinglog.FlgInfo, "GetsIcmpResultBatch req : "+req.String())
+
+	listener, err := thisServer.pingServ.getsIcmpResult(req.GetStream())
+	if err != nil {
+		return err
+	}
+
+	return thisServer.pingServ.sendIcmpResultBatch(server.Context(), listener, req, server.Send)
+}
+
+// Subscribe a
+func (thisServer *grpcServer) Subscribe(server pb.Pinger_SubscribeServer) error {
+	logger.Log(labelinglog.FlgInfo, "Subscribe req : "+peerName(server.Context()))
+
+	return thisServer.pingServ.subscribe(server.Context(), server.Recv, server.Send)
+}
+
+// WatchPingers a
+func (thisServer *grpcServer) WatchPingers(null *pb.Null, server pb.Pinger_WatchPingersServer) error {
+	logger.Log(labelinglog.FlgInfo, "WatchPingers req : "+peerName(server.Context()))
+
+	return thisServer.pingServ.watchPingers(server.Context(), server.Send)
+}
+
+// DiscoverPathMTU a
+func (thisServer *grpcServer) DiscoverPathMTU(ctx context.Context, req *pb.PathMTURequest) (*pb.PathMTUResult, error) {
+	logger.Log(labelinglog.FlgInfo, "DiscoverPathMTU req : "+req.String())
+
+	return thisServer.pingServ.discoverPathMTU(ctx, req)
+}
+
+// WatchTargetState a
+func (thisServer *grpcServer) WatchTargetState(req *pb.StreamRequest, server pb.Pinger_WatchTargetStateServer) error {
+	logger.Log(labelinglog.FlgInfo, "WatchTargetState req : "+req.String())
+
+	listener, err := thisServer.pingServ.watchTargetState(req)
+	if err != nil {
+		return err
+	}
+
+	return listener.sendAll(server.Context(), func(message proto.Message) error {
+		return server.Send(message.(*pb.TargetStateChange))
+	})
+}
+
+// AddTargets a
+func (thisServer *grpcServer) AddTargets(ctx context.Context, req *pb.TargetsRequest) (*pb.TargetsResponse, error) {
+	logger.Log(labelinglog.FlgInfo, "AddTargets req : "+req.String())
+
+	return thisServer.pingServ.addTargets(req)
+}
+
+// RemoveTargets a
+func (thisServer *grpcServer) RemoveTargets(ctx context.Context, req *pb.TargetsRequest) (*pb.TargetsResponse, error) {
+	logger.Log(labelinglog.FlgInfo, "RemoveTargets req : "+req.String())
+
+	return thisServer.pingServ.removeTargets(req)
+}
+
+// UpdatePinger a
+func (thisServer *grpcServer) UpdatePinger(ctx context.Context, req *pb.UpdateRequest) (*pb.PingerInfo, error) {
+	logger.Log(labelinglog.FlgInfo, "UpdatePinger req : "+req.String())
+
+	return thisServer.pingServ.updatePinger(req)
+}
+
+// PingOnce a
+func (thisServer *grpcServer) PingOnce(ctx context.Context, req *pb.PingOnceRequest) (*pb.PingOnceResult, error) {
+	logger.Log(labelinglog.FlgInfo, "PingOnce req : "+req.String())
+
+	return thisServer.pingServ.pingOnce(ctx, req)
 }
@@ -166,7 +166,7 @@ func subMain() {
 		defer logger.Log(labelinglog.FlgInfo, "finish syscall listener")
 		logger.Log(labelinglog.FlgInfo, "start syscall listener")
 
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT, os.Interrupt)
 		for {
 			select {
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/umenosuke/labelinglog"
+
+	"github.com/umenosuke/ping-grpc-server/pinger46"
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+//gRPCのdeadlineより少し前に切り上げて、途中までの結果を返す
+const pingOnceDeadlineMarginMillisec = 100
+
+type tPingOnceCount struct {
+	sent        uint64
+	received    uint64
+	rttSum      int64
+	minRtt      int64
+	maxRtt      int64
+	isCompleted bool
+}
+
+func (thisServer *pingerServer) pingOnce(ctx context.Context, req *pb.PingOnceRequest) (*pb.PingOnceResult, error) {
+	targets := req.GetTargets()
+	if violations := targetsNumViolations(targets, thisServer.config.Limit.TargetsNum); len(violations) > 0 {
+		return nil, errInvalidArgument("invalid targets", violations)
+	}
+	startTargets, violations := resolveTargets(targets)
+	if len(violations) > 0 {
+		return nil, errInvalidArgument("invalid targets", violations)
+	}
+
+	limit := thisServer.config.Limit
+	count := crump(req.GetCount(), limit.PingOnceCount)
+	config := pinger46.DefaultConfig()
+	config.DebugEnable = argDebugFlag
+	config.DebugPrintIntervalSec = debugPrintIntervalSec
+	config.SourceIPAddress = thisServer.config.ICMPSourceIPAddress
+	config.SourceIPv6Address = thisServer.config.ICMPv6SourceIPAddress
+	config.IntervalMillisec = int64(crump(req.GetIntervalMillisec(), limit.IntervalMillisec))
+	config.TimeoutMillisec = int64(crump(req.GetTimeoutMillisec(), limit.TimeoutMillisec))
+	if maxTimeoutMillisec := pinger46.MaxTimeoutMillisec(config.IntervalMillisec, config.Mode, config.MaxHops); config.TimeoutMillisec > maxTimeoutMillisec {
+		config.TimeoutMillisec = maxTimeoutMillisec
+	}
+	config.StatisticsCountsNum = int64(count)
+	config.Count = int64(count)
+
+	icmpID, err := thisServer.pingers.reserveIcmpID()
+	if err != nil {
+		return nil, err
+	}
+
+	pinger := newPinger(icmpID, config)
+	for _, target := range startTargets {
+		if err := pinger.AddResolvedTarget(target.targetID, target.target.GetTargetIP(), target.target.GetComment()); err != nil {
+			thisServer.pingers.releaseIcmpID(icmpID)
+			return nil, errInternal("add target fail : " + err.Error())
+		}
+	}
+	chIcmpResult := pinger.GetChIcmpResult(len(targets) * 2)
+
+	//送信開始のばらし分と最後の一発のタイムアウトまで待つ
+	runDuration := time.Duration(int64(count+1)*config.IntervalMillisec+config.TimeoutMillisec) * time.Millisecond
+	runCtx, runCtxCancel := context.WithTimeout(ctx, runDuration)
+	defer runCtxCancel()
+	if deadline, ok := ctx.Deadline(); ok {
+		var deadlineCancel context.CancelFunc
+		runCtx, deadlineCancel = context.WithDeadline(runCtx, deadline.Add(-pingOnceDeadlineMarginMillisec*time.Millisecond))
+		defer deadlineCancel()
+	}
+
+	chRunDone := make(chan struct{})
+	go (func() {
+		//ソケットが閉じてからICMP識別子を返す
+		defer thisServer.pingers.releaseIcmpID(icmpID)
+		defer close(chRunDone)
+		if err := pinger.Run(runCtx); err != nil {
+			logger.Log(labelinglog.FlgWarn, "PingOnce fail : "+err.Error())
+		}
+	})()
+
+	counts := make(map[pinger46.TargetID]*tPingOnceCount)
+	for _, targetID := range pinger.GetTargetsOrder() {
+		counts[targetID] = &tPingOnceCount{
+			minRtt: -1,
+			maxRtt: -1,
+		}
+	}
+	completedNum := 0
+	addResult := func(result pinger46.IcmpResult) {
+		c, ok := counts[result.IcmpTargetID]
+		if !ok || c.isCompleted {
+			return
+		}
+
+		switch result.ResultType {
+		case pinger46.IcmpResultTypeReceiveAfterTimeout:
+			return
+		case pinger46.IcmpResultTypeRedirect:
+			return
+		case pinger46.IcmpResultTypeReceive:
+			rtt := result.ReceiveTimeUnixNanosec - result.SendTimeUnixNanosec
+			c.received++
+			c.rttSum += rtt
+			if c.minRtt < 0 || rtt < c.minRtt {
+				c.minRtt = rtt
+			}
+			if rtt > c.maxRtt {
+				c.maxRtt = rtt
+			}
+		}
+		c.sent++
+
+		if c.sent >= count {
+			c.isCompleted = true
+			completedNum++
+		}
+	}
+	//終わる前に渡された結果を拾う
+	drainResults := func() {
+		for {
+			select {
+			case result := <-chIcmpResult:
+				addResult(result)
+			default:
+				return
+			}
+		}
+	}
+	(func() {
+		for completedNum < len(counts) {
+			select {
+			case <-runCtx.Done():
+				return
+			case <-chRunDone:
+				drainResults()
+				return
+			case <-pinger.GetChCompleted():
+				//全ての結果は完了より先にチャネルに入っている
+				drainResults()
+				return
+			case result := <-chIcmpResult:
+				addResult(result)
+			}
+		}
+	})()
+	isCompleted := completedNum >= len(counts)
+	runCtxCancel()
+
+	//結果を落としていたらタイムアウトと区別できないのでエラーにする
+	if drops := pinger.GetDropCounts(); !isCompleted && drops.Result+drops.ResultSubscriber > 0 {
+		return nil, errInternal("PingOnce results dropped : " + strconv.FormatInt(drops.Result+drops.ResultSubscriber, 10))
+	}
+
+	info := pinger.GetInfo()
+	results := make([]*pb.PingOnceResult_TargetResult, 0, len(info.TargetsOrder))
+	for _, targetID := range info.TargetsOrder {
+		target := info.Targets[targetID]
+		c := counts[targetID]
+		result := &pb.PingOnceResult_TargetResult{
+			TargetIP:      target.IPAddress,
+			Comment:       target.Comment,
+			TargetID:      pinger46.BinIPAddress2BinIPv4(targetID.BinIP),
+			TargetID128:   pinger46.BinIPAddress2Bytes(targetID.BinIP),
+			TargetBinIP:   pinger46.BinIPAddress2String(targetID.BinIP),
+			ProbeType:     probeType2pb(targetID.ProbeType),
+			Port:          uint32(targetID.Port),
+			SentCount:     c.sent,
+			ReceivedCount: c.received,
+			MinRttNanosec: c.minRtt,
+			AvgRttNanosec: -1,
+			MaxRttNanosec: c.maxRtt,
+		}
+		if c.sent > 0 {
+			result.LossPercent = float64(c.sent-c.received) * 100 / float64(c.sent)
+		}
+		if c.received > 0 {
+			result.AvgRttNanosec = c.rttSum / int64(c.received)
+		}
+		results = append(results, result)
+	}
+
+	return &pb.PingOnceResult{
+		Targets:   results,
+		Completed: isCompleted,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/umenosuke/labelinglog"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+//pingerの作成から終了までの出来事を購読者へ配る
+type tPingerEvents struct {
+	sync.Mutex
+	list []*tPingerEventSubscriber
+}
+
+type tPingerEventSubscriber struct {
+	ch chan *pb.PingerEvent
+	//詰まって落とした数、tPingerEventsのロックで保護
+	droppedCount uint64
+	//落とした時に知らせる
+	chDropped chan struct{}
+}
+
+func newPingerEvents() *tPingerEvents {
+	return &tPingerEvents{
+		list: make([]*tPingerEventSubscriber, 0),
+	}
+}
+
+func (thisEvents *tPingerEvents) subscribe(bufferSize uint) *tPingerEventSubscriber {
+	thisEvents.Lock()
+	defer thisEvents.Unlock()
+
+	subscriber := &tPingerEventSubscriber{
+		ch:        make(chan *pb.PingerEvent, bufferSize),
+		chDropped: make(chan struct{}, 1),
+	}
+	thisEvents.list = append(thisEvents.list, subscriber)
+
+	return subscriber
+}
+
+func (thisEvents *tPingerEvents) unsubscribe(subscriber *tPingerEventSubscriber) {
+	thisEvents.Lock()
+	defer thisEvents.Unlock()
+
+	for i, s := range thisEvents.list {
+		if s == subscriber {
+			thisEvents.list = append(thisEvents.list[:i], thisEvents.list[i+1:]...)
+			return
+		}
+	}
+}
+
+//publish 詰まっている購読者には落とした数を数えて知らせる
+func (thisEvents *tPingerEvents) publish(event *pb.PingerEvent) {
+	event.UnixNanosec = uint64(time.Now().UnixNano())
+
+	thisEvents.Lock()
+	defer thisEvents.Unlock()
+	for _, subscriber := range thisEvents.list {
+		select {
+		case subscriber.ch <- event:
+		default:
+			logger.Log(labelinglog.FlgWarn, "busy pinger event subscriber skip")
+			subscriber.droppedCount++
+			select {
+			case subscriber.chDropped <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+//takeDroppedCount 前回からの落とした数を返す
+func (thisEvents *tPingerEvents) takeDroppedCount(subscriber *tPingerEventSubscriber) uint64 {
+	thisEvents.Lock()
+	defer thisEvents.Unlock()
+
+	droppedCount := subscriber.droppedCount
+	subscriber.droppedCount = 0
+
+	return droppedCount
+}
+
+func newPingerEventGapMarker(droppedCount uint64) *pb.PingerEvent {
+	return &pb.PingerEvent{
+		Type:         pb.PingerEvent_PingerEventTypeGap,
+		UnixNanosec:  uint64(time.Now().UnixNano()),
+		DroppedCount: droppedCount,
+	}
+}
+
+//publishWithInfo 動いているpingerの出来事にはその時点の詳細を付ける、既に終了していれば終了の出来事に任せる
+func (thisServer *pingerServer) publishWithInfo(eventType pb.PingerEvent_EventType, handle string) {
+	info, err := thisServer.info(handle)
+	if err != nil {
+		return
+	}
+
+	thisServer.events.publish(&pb.PingerEvent{
+		Type:        eventType,
+		Handle:      handle,
+		Description: info.GetDescription(),
+		Info:        info,
+	})
+}
+
+//watchPingers 先に購読してから一覧を送るので、一覧とその後の出来事の間に抜けはない、重なった出来事は届くことがある
+//出来事を落とした時は、抜けを知らせてから一覧を送り直す
+func (thisServer *pingerServer) watchPingers(ctx context.Context, send func(*pb.PingerWatchMessage) error) error {
+	subscriber := thisServer.events.subscribe(thisServer.config.GrpcStreamBuffer)
+	defer thisServer.events.unsubscribe(subscriber)
+
+	sendSnapshot := func() error {
+		return send(&pb.PingerWatchMessage{
+			Type:    pb.PingerWatchMessage_PingerWatchMessageTypeSnapshot,
+			Pingers: thisServer.getPingersIDList(),
+		})
+	}
+	if err := sendSnapshot(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-subscriber.chDropped:
+			droppedCount := thisServer.events.takeDroppedCount(subscriber)
+			//溜まっている出来事は送り直す一覧に含まれる
+			for len(subscriber.ch) > 0 {
+				<-subscriber.ch
+			}
+			if err := send(&pb.PingerWatchMessage{
+				Type:  pb.PingerWatchMessage_PingerWatchMessageTypeEvent,
+				Event: newPingerEventGapMarker(droppedCount),
+			}); err != nil {
+				return err
+			}
+			if err := sendSnapshot(); err != nil {
+				return err
+			}
+		case event := <-subscriber.ch:
+			if err := send(&pb.PingerWatchMessage{
+				Type:  pb.PingerWatchMessage_PingerWatchMessageTypeEvent,
+				Event: event,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
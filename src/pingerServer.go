@@ -2,14 +2,18 @@ package main
 
 import (
 	"context"
-	"math/rand"
+	"errors"
 	"strconv"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/umenosuke/labelinglog"
-	"github.com/umenosuke/pinger4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/umenosuke/ping-grpc-server/pinger46"
 	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
 )
 
@@ -17,6 +21,7 @@ type pingerServer struct {
 	chStartReq   chan tStartReq
 	ctxStartWait context.Context
 	pingers      *tPingers
+	events       *tPingerEvents
 	config       Config
 }
 
@@ -27,7 +32,8 @@ func newPingerServer(config Config) pingerServer {
 	return pingerServer{
 		chStartReq:   make(chan tStartReq, 10),
 		ctxStartWait: childCtx,
-		pingers:      &tPingers{list: make(map[uint16]*tPingersEntry)},
+		pingers:      &tPingers{list: make(map[string]*tPingersEntry), icmpIDs: make(map[uint16]struct{})},
+		events:       newPingerEvents(),
 		config:       config,
 	}
 }
@@ -63,54 +69,52 @@ func (thisServer *pingerServer) serv(ctx context.Context) {
 func (thisServer *pingerServer) pingerStart(ctx context.Context, request tStartReq) {
 	wgChild := sync.WaitGroup{}
 
-	config := pinger4.DefaultConfig()
-	config.DebugEnable = argDebugFlag
-	config.DebugPrintIntervalSec = debugPrintIntervalSec
-	config.SourceIPAddress = thisServer.config.ICMPSourceIPAddress
-	limit := thisServer.config.Limit
-	config.IntervalMillisec = int64(crump(request.intervalMillisec, limit.IntervalMillisec))
-	config.TimeoutMillisec = int64(crump(request.timeoutMillisec, limit.TimeoutMillisec))
-	config.StatisticsCountsNum = int64(crump(request.statisticsCountsNum, limit.StatisticsCountsNum))
+	params := thisServer.startParams(request)
 
-	id := request.id
-	pinger := pinger4.New(int(id), config)
-	pinger.SetLogWriter(labelinglog.FlgsetAll, serverLogWriter)
-	if argDebugFlag {
-		pinger.SetLogEnableLevel(labelinglog.FlgsetAll)
-	} else {
-		pinger.SetLogEnableLevel(labelinglog.FlgsetCommon)
-	}
+	handle := request.handle
+	pinger := newPinger(request.icmpID, params.config)
 
-	targets := request.targets
-	for _, target := range targets {
-		pinger.AddTarget(target.GetTargetIP(), target.GetComment())
+	targetResults := make([]*pb.TargetsResponse_TargetResult, 0, len(request.targets))
+	for _, target := range request.targets {
+		result := &pb.TargetsResponse_TargetResult{
+			TargetIP: target.target.GetTargetIP(),
+			Success:  true,
+		}
+		err := target.err
+		if err == nil {
+			err = pinger.AddResolvedTarget(target.targetID, target.target.GetTargetIP(), target.target.GetComment())
+		}
+		if err != nil {
+			result.Success = false
+			result.Error = err.Error()
+		}
+		targetResults = append(targetResults, result)
 	}
 
 	childCtx, childCtxCancel := context.WithCancel(ctx)
 	defer childCtxCancel()
 
-	pingerStopTime := time.Duration(crump(request.stopPingerSec, limit.StopPingerSec)) * time.Second
+	pingerStopTime := time.Duration(params.stopPingerSec) * time.Second
 
 	p := &tPingerWrap{
-		pinger:            &pinger,
-		idStr:             strconv.Itoa(pinger.GetIcmpID()),
-		description:       request.description,
-		startUnixNanosec:  uint64(time.Now().UnixNano()),
-		expireUnixNanosec: uint64(time.Now().Add(pingerStopTime).UnixNano()),
-		cancelFunc:        childCtxCancel,
-		chResultListener: struct {
-			sync.Mutex
-			list []chan<- *pb.IcmpResult
-		}{
-			list: make([]chan<- *pb.IcmpResult, 0),
-		},
-		chStatisticsListener: struct {
-			sync.Mutex
-			list []chan<- *pb.Statistics
-		}{
-			list: make([]chan<- *pb.Statistics, 0),
-		},
-		statisticsInterval: crump(request.statisticsIntervalSec, limit.StatisticsIntervalSec),
+		pinger:                pinger,
+		idStr:                 handle,
+		description:           request.description,
+		startUnixNanosec:      uint64(time.Now().UnixNano()),
+		expireUnixNanosec:     uint64(time.Now().Add(pingerStopTime).UnixNano()),
+		cancelFunc:            childCtxCancel,
+		chResultListener:      newStreamListeners(),
+		chStatisticsListener:  newStreamListeners(),
+		chHopTableListener:    newStreamListeners(),
+		chTargetStateListener: newStreamListeners(),
+		statisticsInterval:    params.statisticsIntervalSec,
+		chExpireChanged:       make(chan struct{}, 1),
+		chTargetsChanged:      newTargetsChanged(),
+		startTargetResults:    targetResults,
+		termination:           newTermination(),
+		replayBufferSize:      params.replayBufferSize,
+		resultReplay:          newReplayRing(params.replayBufferSize),
+		statisticsReplay:      newReplayRing(params.replayBufferSize),
 	}
 
 	wgChild.Add(1)
@@ -126,84 +130,278 @@ func (thisServer *pingerServer) pingerStart(ctx context.Context, request tStartR
 		defer logger.Log(labelinglog.FlgDebug, "(id "+p.idStr+")"+" finish time.After")
 		logger.Log(labelinglog.FlgDebug, "(id "+p.idStr+")"+" Start time.After")
 
-		select {
-		case <-childCtx.Done():
-		case <-time.After(pingerStopTime):
+		for {
+			remaining := time.Duration(int64(p.getExpireUnixNanosec()) - time.Now().UnixNano())
+			select {
+			case <-childCtx.Done():
+				return
+			case <-p.chExpireChanged:
+			case <-time.After(remaining):
+				p.termination.record(pb.TerminationReason_TerminationReasonExpired, "", "")
+				return
+			}
 		}
 	})()
 
 	(func() {
 		thisServer.pingers.Lock()
 		defer thisServer.pingers.Unlock()
-		if pinger, ok := thisServer.pingers.list[id]; ok {
+		if pinger, ok := thisServer.pingers.list[handle]; ok {
 			pinger.entry = p
 			pinger.ctxStartWaitDoneFunc()
 		} else {
 			childCtxCancel()
 		}
 	})()
+	thisServer.publishWithInfo(pb.PingerEvent_PingerEventTypeStarted, handle)
 
 	wgChild.Wait()
-	thisServer.pingers.deletePinger(id)
-}
+	thisServer.pingers.deletePinger(handle)
 
-func (thisServer *pingerServer) pingerStartReq(req *pb.StartRequest) *pb.PingerID {
-	targets := req.GetTargets()
-	if targets == nil {
-		return &pb.PingerID{}
-	} else if len(targets) <= 0 {
-		return &pb.PingerID{}
-	}
-
-	logger.Log(labelinglog.FlgDebug, "pingers len: "+strconv.Itoa(len(thisServer.pingers.list)))
-	id := uint16(rand.Uint32())
-	retryCount := 0
-	for {
-		if retryCount > 0xffff {
-			logger.Log(labelinglog.FlgError, "pingerStart Busy")
-			return &pb.PingerID{}
-		}
+	event := &pb.PingerEvent{
+		Type:        pb.PingerEvent_PingerEventTypeStopped,
+		Handle:      handle,
+		Description: p.description,
+		Summary:     p.getSummary(),
+	}
+	if event.Summary.GetReason() == pb.TerminationReason_TerminationReasonExpired {
+		event.Type = pb.PingerEvent_PingerEventTypeExpired
+	}
+	thisServer.events.publish(event)
+}
 
-		if _, ok := thisServer.pingers.list[id]; ok {
-			id = uint16(rand.Uint32())
-			retryCount++
-		} else {
-			break
-		}
+func newPinger(icmpID uint16, config pinger46.Config) *pinger46.Pinger {
+	pinger := pinger46.New(int(icmpID), config)
+	pinger.SetLogWriter(labelinglog.FlgsetAll, serverLogWriter)
+	if argDebugFlag {
+		pinger.SetLogEnableLevel(labelinglog.FlgsetAll)
+	} else {
+		pinger.SetLogEnableLevel(labelinglog.FlgsetCommon)
 	}
 
-	{
-		childCtxStartWait, childCtxStartWaitDoneFunc := context.WithCancel(thisServer.ctxStartWait)
-		thisServer.pingers.addPinger(id, &tPingersEntry{
-			ctxStartWait:         childCtxStartWait,
-			ctxStartWaitDoneFunc: childCtxStartWaitDoneFunc,
-			entry:                nil,
-		})
+	return &pinger
+}
+
+func (thisServer *pingerServer) pingerStartReq(req *pb.StartRequest) (*pb.StartResponse, error) {
+	targets := req.GetTargets()
+	if violations := targetsNumViolations(targets, thisServer.config.Limit.TargetsNum); len(violations) > 0 {
+		return nil, errInvalidArgument("invalid targets", violations)
+	}
+	//strictでなければ受け付けられない対象は対象ごとの結果で返し、一つも受け付けられない時だけ断る
+	startTargets, violations := resolveTargets(targets)
+	if len(violations) > 0 && (req.GetStrict() || len(violations) >= len(targets)) {
+		return nil, errInvalidArgument("invalid targets", violations)
 	}
 
-	thisServer.chStartReq <- tStartReq{
-		id:                    id,
+	request := tStartReq{
 		description:           req.GetDescription(),
-		targets:               targets,
+		targets:               startTargets,
 		intervalMillisec:      req.GetIntervalMillisec(),
 		timeoutMillisec:       req.GetTimeoutMillisec(),
 		stopPingerSec:         req.GetStopPingerSec(),
 		statisticsCountsNum:   req.GetStatisticsCountsNum(),
 		statisticsIntervalSec: req.GetStatisticsIntervalSec(),
+		mode:                  req.GetMode(),
+		maxHops:               req.GetMaxHops(),
+		ttl:                   req.GetTTL(),
+		tos:                   req.GetTOS(),
+		payloadSize:           req.GetPayloadSize(),
+		payloadPattern:        req.GetPayloadPattern(),
+		dontFragment:          req.GetDontFragment(),
+		statisticsWindowsSec:  req.GetStatisticsWindowsSec(),
+		downLossCount:         req.GetDownLossCount(),
+		upSuccessCount:        req.GetUpSuccessCount(),
+		degradedLossPercent:   req.GetDegradedLossPercent(),
+		holdDownMillisec:      req.GetHoldDownMillisec(),
+		count:                 req.GetCount(),
+		replayBufferSize:      req.GetReplayBufferSize(),
+	}
+	params := thisServer.startParams(request)
+	if req.GetStrict() && len(params.adjustments) > 0 {
+		for _, adjustment := range params.adjustments {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       adjustment.GetField(),
+				Description: adjustment.GetDescription(),
+			})
+		}
+		return nil, errInvalidArgument("out of limit values in strict mode", violations)
+	}
+
+	childCtxStartWait, childCtxStartWaitDoneFunc := context.WithCancel(thisServer.ctxStartWait)
+	pingersEntry := &tPingersEntry{
+		ctxStartWait:         childCtxStartWait,
+		ctxStartWaitDoneFunc: childCtxStartWaitDoneFunc,
+		entry:                nil,
+	}
+	handle, err := thisServer.pingers.addPinger(pingersEntry)
+	if err != nil {
+		childCtxStartWaitDoneFunc()
+		logger.Log(labelinglog.FlgError, "pingerStart fail : "+err.Error())
+		return nil, err
 	}
+	logger.Log(labelinglog.FlgDebug, "pinger added : "+handle+" (icmp id "+strconv.Itoa(int(pingersEntry.icmpID))+")")
+	thisServer.events.publish(&pb.PingerEvent{
+		Type:        pb.PingerEvent_PingerEventTypeCreated,
+		Handle:      handle,
+		Description: request.description,
+	})
+
+	request.handle = handle
+	request.icmpID = pingersEntry.icmpID
+	thisServer.chStartReq <- request
+
+	<-pingersEntry.ctxStartWait.Done()
+	p := pingersEntry.entry
+	if p == nil {
+		return nil, status.Error(codes.Unavailable, "pinger start canceled")
+	}
+	info := p.pinger.GetInfo()
+
+	return &pb.StartResponse{
+		Handle:                handle,
+		IcmpID:                uint32(info.IcmpID),
+		IntervalMillisec:      uint64(info.IntervalMillisec),
+		TimeoutMillisec:       uint64(info.TimeoutMillisec),
+		StatisticsCountsNum:   uint64(info.StatisticsCountsNum),
+		StatisticsIntervalSec: p.getStatisticsInterval(),
+		StartUnixNanosec:      p.startUnixNanosec,
+		ExpireUnixNanosec:     p.getExpireUnixNanosec(),
+		Count:                 uint64(info.Count),
+		ReplayBufferSize:      p.replayBufferSize,
+		Targets:               p.startTargetResults,
+		Adjustments:           params.adjustments,
+	}, nil
+}
+
+//targetsNumViolations 対象の数はリクエスト全体で判断する
+func targetsNumViolations(targets []*pb.StartRequest_IcmpTarget, limit tValueRange) []*errdetails.BadRequest_FieldViolation {
+	if len(targets) <= 0 {
+		return []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       "Targets",
+				Description: "at least one target is required",
+			},
+		}
+	}
+	if limit.Max > 0 && uint64(len(targets)) > limit.Max {
+		return []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       "Targets",
+				Description: "too many targets, max " + strconv.FormatUint(limit.Max, 10),
+			},
+		}
+	}
+
+	return nil
+}
+
+//resolveTargets 対象ごとに一度だけ名前解決する、解決できない対象と重複した対象はviolationsにも入れる
+func resolveTargets(targets []*pb.StartRequest_IcmpTarget) ([]tStartTarget, []*errdetails.BadRequest_FieldViolation) {
+	startTargets := make([]tStartTarget, 0, len(targets))
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0)
+	targetIDs := make(map[pinger46.TargetID]int)
+	for i, target := range targets {
+		startTarget := tStartTarget{
+			target: target,
+		}
+		targetID, err := pinger46.ResolveTarget(target.GetTargetIP())
+		if err != nil {
+			startTarget.err = err
+		} else if j, ok := targetIDs[targetID]; ok {
+			startTarget.err = errors.New("duplicate of Targets[" + strconv.Itoa(j) + "] : " + target.GetTargetIP())
+		} else {
+			startTarget.targetID = targetID
+			targetIDs[targetID] = i
+		}
+		if startTarget.err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "Targets[" + strconv.Itoa(i) + "].TargetIP",
+				Description: startTarget.err.Error(),
+			})
+		}
+		startTargets = append(startTargets, startTarget)
+	}
+
+	return startTargets, violations
+}
+
+func statisticsWindowsSec(windowsSec []uint64, limit tValueLimit) []int64 {
+	res := make([]int64, 0, len(windowsSec))
+	for _, windowSec := range windowsSec {
+		if uint64(len(res)) >= limit.StatisticsWindowsNum.Max {
+			break
+		}
+
+		windowSec := int64(crump(windowSec, limit.StatisticsWindowSec))
+		isDuplicate := false
+		for _, v := range res {
+			if v == windowSec {
+				isDuplicate = true
+				break
+			}
+		}
+		if !isDuplicate {
+			res = append(res, windowSec)
+		}
+	}
+
+	return res
+}
 
-	return &pb.PingerID{
-		PingerID: uint32(id),
+func statisticsWindowsSec2pb(windowsSec []int64) []uint64 {
+	res := make([]uint64, 0, len(windowsSec))
+	for _, windowSec := range windowsSec {
+		res = append(res, uint64(windowSec))
 	}
+
+	return res
 }
 
-func (thisServer *pingerServer) pingerStop(id uint16) {
-	if pinger, ok := thisServer.pingers.getPinger(id); ok {
+func (thisServer *pingerServer) getPingerWrap(handle string) (*tPingerWrap, error) {
+	if pinger, ok := thisServer.pingers.getPinger(handle); ok {
 		<-pinger.ctxStartWait.Done()
 		if pinger.entry != nil {
-			pinger.entry.cancelFunc()
+			return pinger.entry, nil
 		}
 	}
+
+	return nil, errPingerNotFound(handle)
+}
+
+func (thisServer *pingerServer) pingerStop(handle string, stoppedBy string) error {
+	p, err := thisServer.getPingerWrap(handle)
+	if err != nil {
+		return err
+	}
+	p.stop(pb.TerminationReason_TerminationReasonStopped, stoppedBy)
+
+	return nil
+}
+
+func (thisServer *pingerServer) pingerPause(handle string) error {
+	p, err := thisServer.getPingerWrap(handle)
+	if err != nil {
+		return err
+	}
+	if err := p.pinger.Pause(); err != nil {
+		return errFailedPrecondition(err.Error())
+	}
+	thisServer.publishWithInfo(pb.PingerEvent_PingerEventTypePaused, handle)
+
+	return nil
+}
+
+func (thisServer *pingerServer) pingerResume(handle string) error {
+	p, err := thisServer.getPingerWrap(handle)
+	if err != nil {
+		return err
+	}
+	if err := p.pinger.Resume(); err != nil {
+		return errFailedPrecondition(err.Error())
+	}
+	thisServer.publishWithInfo(pb.PingerEvent_PingerEventTypeResumed, handle)
+
+	return nil
 }
 
 func (thisServer *pingerServer) getPingersIDList() *pb.PingerList {
@@ -213,11 +411,16 @@ func (thisServer *pingerServer) getPingersIDList() *pb.PingerList {
 	pingers := make([]*pb.PingerList_PingerSumally, 0, len(thisServer.pingers.list))
 	for key, pinger := range thisServer.pingers.list {
 		if pinger.entry != nil {
+			pauseStatus := pinger.entry.pinger.GetPauseStatus()
 			pingers = append(pingers, &pb.PingerList_PingerSumally{
-				PingerID:          uint32(key),
-				Description:       pinger.entry.description,
-				StartUnixNanosec:  pinger.entry.startUnixNanosec,
-				ExpireUnixNanosec: pinger.entry.expireUnixNanosec,
+				Handle:             key,
+				IcmpID:             uint32(pinger.icmpID),
+				Description:        pinger.entry.description,
+				StartUnixNanosec:   pinger.entry.startUnixNanosec,
+				ExpireUnixNanosec:  pinger.entry.getExpireUnixNanosec(),
+				Paused:             pauseStatus.IsPaused,
+				PausedUnixNanosec:  uint64(pauseStatus.PausedUnixNanosec),
+				TotalPausedNanosec: uint64(pauseStatus.TotalPausedNanosec),
 			})
 		}
 	}
@@ -227,71 +430,287 @@ func (thisServer *pingerServer) getPingersIDList() *pb.PingerList {
 	}
 }
 
-func (thisServer *pingerServer) info(id uint16) *pb.PingerInfo {
-	if pinger, ok := thisServer.pingers.getPinger(id); ok {
-		<-pinger.ctxStartWait.Done()
-		if pinger.entry != nil {
-			info := pinger.entry.pinger.GetInfo()
-
-			targets := make([]*pb.PingerInfo_IcmpTarget, 0)
-
-			for _, id := range info.TargetsOrder {
-				if target, ok := info.Targets[id]; ok {
-					targets = append(targets, &pb.PingerInfo_IcmpTarget{
-						TargetID:    uint32(id),
-						TargetIP:    target.IPAddress,
-						TargetBinIP: pinger4.BinIPv4Address2String(id),
-						Comment:     target.Comment,
-					})
-				}
-			}
-
-			return &pb.PingerInfo{
-				Description:           pinger.entry.description,
-				Targets:               targets,
-				IntervalMillisec:      uint64(info.IntervalMillisec),
-				TimeoutMillisec:       uint64(info.TimeoutMillisec),
-				StatisticsCountsNum:   uint64(info.StatisticsCountsNum),
-				StartUnixNanosec:      pinger.entry.startUnixNanosec,
-				ExpireUnixNanosec:     pinger.entry.expireUnixNanosec,
-				StatisticsIntervalSec: pinger.entry.statisticsInterval,
-			}
+func (thisServer *pingerServer) info(handle string) (*pb.PingerInfo, error) {
+	p, err := thisServer.getPingerWrap(handle)
+	if err != nil {
+		return nil, err
+	}
+	info := p.pinger.GetInfo()
+	pauseStatus := p.pinger.GetPauseStatus()
+	drops := p.pinger.GetDropCounts()
+
+	targets := make([]*pb.PingerInfo_IcmpTarget, 0)
+
+	for _, id := range info.TargetsOrder {
+		if target, ok := info.Targets[id]; ok {
+			targets = append(targets, &pb.PingerInfo_IcmpTarget{
+				TargetID:    pinger46.BinIPAddress2BinIPv4(id.BinIP),
+				TargetIP:    target.IPAddress,
+				TargetBinIP: pinger46.BinIPAddress2String(id.BinIP),
+				Comment:     target.Comment,
+				TargetID128: pinger46.BinIPAddress2Bytes(id.BinIP),
+				ProbeType:   probeType2pb(id.ProbeType),
+				Port:        uint32(id.Port),
+			})
 		}
 	}
 
-	return &pb.PingerInfo{}
+	return &pb.PingerInfo{
+		Handle:                   handle,
+		IcmpID:                   uint32(info.IcmpID),
+		Description:              p.description,
+		Targets:                  targets,
+		IntervalMillisec:         uint64(info.IntervalMillisec),
+		TimeoutMillisec:          uint64(info.TimeoutMillisec),
+		StatisticsCountsNum:      uint64(info.StatisticsCountsNum),
+		StartUnixNanosec:         p.startUnixNanosec,
+		ExpireUnixNanosec:        p.getExpireUnixNanosec(),
+		StatisticsIntervalSec:    p.getStatisticsInterval(),
+		Mode:                     mode2pb(info.Mode),
+		MaxHops:                  uint64(info.MaxHops),
+		TTL:                      uint64(info.TTL),
+		TOS:                      uint64(info.TOS),
+		PayloadSize:              uint64(info.PayloadSize),
+		PayloadPattern:           info.PayloadPattern,
+		DontFragment:             info.DontFragment,
+		StatisticsWindowsSec:     statisticsWindowsSec2pb(info.StatisticsWindowsSec),
+		DownLossCount:            uint64(info.DownLossCount),
+		UpSuccessCount:           uint64(info.UpSuccessCount),
+		DegradedLossPercent:      info.DegradedLossPercent,
+		HoldDownMillisec:         uint64(info.HoldDownMillisec),
+		Count:                    uint64(info.Count),
+		ReplayBufferSize:         p.replayBufferSize,
+		ResultsDroppedCount:      p.chResultListener.getDroppedCount(),
+		StatisticsDroppedCount:   p.chStatisticsListener.getDroppedCount(),
+		HopTablesDroppedCount:    p.chHopTableListener.getDroppedCount(),
+		TargetStatesDroppedCount: p.chTargetStateListener.getDroppedCount(),
+		PingerDroppedCount:       uint64(drops.Result + drops.ResultSubscriber + drops.PathChangeSubscriber + drops.TargetStateSubscriber),
+		ResponsesDroppedCount:    uint64(drops.Response),
+		Paused:                   pauseStatus.IsPaused,
+		PausedUnixNanosec:        uint64(pauseStatus.PausedUnixNanosec),
+		TotalPausedNanosec:       uint64(pauseStatus.TotalPausedNanosec),
+	}, nil
 }
 
-func (thisServer *pingerServer) getsIcmpResult(id uint16) <-chan *pb.IcmpResult {
-	ch := make(chan *pb.IcmpResult, thisServer.config.GrpcStreamBuffer)
+func (thisServer *pingerServer) getsIcmpResult(req *pb.StreamRequest) (*tStreamListener, error) {
+	filter, err := newResultFilter(req)
+	if err != nil {
+		return nil, err
+	}
 
-	if pinger, ok := thisServer.pingers.getPinger(id); ok {
-		<-pinger.ctxStartWait.Done()
-		if pinger.entry != nil {
-			pinger.entry.addResultListener(ch)
+	p, err := thisServer.getPingerWrap(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+
+	listener := thisServer.newStreamListener(req, newResultGapMarker)
+	listener.match = func(message proto.Message) bool {
+		return filter.match(message.(*pb.IcmpResult))
+	}
+	p.addResultListener(listener, req.GetReplayFromSequence(), req.GetReplayFromUnixNanosec())
+
+	return listener, nil
+}
+
+func (thisServer *pingerServer) getsStatistics(req *pb.StreamRequest) (*tStreamListener, error) {
+	p, err := thisServer.getPingerWrap(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+
+	listener := thisServer.newStreamListener(req, newStatisticsGapMarker)
+	p.addStatisticsListener(listener, req.GetReplayFromSequence(), req.GetReplayFromUnixNanosec())
+
+	return listener, nil
+}
+
+func (thisServer *pingerServer) getsHopTable(req *pb.StreamRequest) (*tStreamListener, error) {
+	p, err := thisServer.getPingerWrap(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+
+	listener := thisServer.newStreamListener(req, newHopTableGapMarker)
+	p.addHopTableListener(listener)
+
+	return listener, nil
+}
+
+func (thisServer *pingerServer) watchTargetState(req *pb.StreamRequest) (*tStreamListener, error) {
+	p, err := thisServer.getPingerWrap(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+
+	listener := thisServer.newStreamListener(req, newTargetStateGapMarker)
+	p.addTargetStateListener(listener)
+
+	return listener, nil
+}
+
+func (thisServer *pingerServer) addTargets(req *pb.TargetsRequest) (*pb.TargetsResponse, error) {
+	return thisServer.changeTargets(req, func(p *pinger46.Pinger, target *pb.StartRequest_IcmpTarget) error {
+		return p.AddTarget(target.GetTargetIP(), target.GetComment())
+	})
+}
+
+func (thisServer *pingerServer) removeTargets(req *pb.TargetsRequest) (*pb.TargetsResponse, error) {
+	return thisServer.changeTargets(req, func(p *pinger46.Pinger, target *pb.StartRequest_IcmpTarget) error {
+		return p.RemoveTarget(target.GetTargetIP())
+	})
+}
+
+func (thisServer *pingerServer) changeTargets(req *pb.TargetsRequest, change func(*pinger46.Pinger, *pb.StartRequest_IcmpTarget) error) (*pb.TargetsResponse, error) {
+	p, err := thisServer.getPingerWrap(req.GetHandle())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetTargets()) <= 0 {
+		return nil, errInvalidArgument("invalid targets", []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       "Targets",
+				Description: "at least one target is required",
+			},
+		})
+	}
+
+	isChanged := false
+	results := make([]*pb.TargetsResponse_TargetResult, 0, len(req.GetTargets()))
+	for _, target := range req.GetTargets() {
+		result := &pb.TargetsResponse_TargetResult{
+			TargetIP: target.GetTargetIP(),
+			Success:  true,
+		}
+		if err := change(p.pinger, target); err != nil {
+			result.Success = false
+			result.Error = err.Error()
 		} else {
-			close(ch)
+			isChanged = true
 		}
-	} else {
-		close(ch)
+		results = append(results, result)
+	}
+	if isChanged {
+		p.chTargetsChanged.notify()
+		thisServer.publishWithInfo(pb.PingerEvent_PingerEventTypeUpdated, p.idStr)
 	}
 
-	return ch
+	return &pb.TargetsResponse{
+		Results: results,
+	}, nil
 }
 
-func (thisServer *pingerServer) getsStatistics(id uint16) <-chan *pb.Statistics {
-	ch := make(chan *pb.Statistics, thisServer.config.GrpcStreamBuffer)
+func (thisServer *pingerServer) updatePinger(req *pb.UpdateRequest) (*pb.PingerInfo, error) {
+	handle := req.GetHandle()
+	p, err := thisServer.getPingerWrap(handle)
+	if err != nil {
+		return nil, err
+	}
+	limit := thisServer.config.Limit
 
-	if pinger, ok := thisServer.pingers.getPinger(id); ok {
-		<-pinger.ctxStartWait.Done()
-		if pinger.entry != nil {
-			pinger.entry.addStatisticsListener(ch)
-		} else {
-			close(ch)
+	intervalMillisec := int64(0)
+	if req.GetIntervalMillisec() > 0 {
+		intervalMillisec = int64(crump(req.GetIntervalMillisec(), limit.IntervalMillisec))
+	}
+	timeoutMillisec := int64(0)
+	if req.GetTimeoutMillisec() > 0 {
+		timeoutMillisec = int64(crump(req.GetTimeoutMillisec(), limit.TimeoutMillisec))
+	}
+	if err := p.pinger.SetIntervalTimeout(intervalMillisec, timeoutMillisec); err != nil {
+		return nil, errInvalidArgument(err.Error(), []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       "IntervalMillisec",
+				Description: err.Error(),
+			},
+			{
+				Field:       "TimeoutMillisec",
+				Description: err.Error(),
+			},
+		})
+	}
+
+	if req.GetStatisticsIntervalSec() > 0 {
+		p.setStatisticsInterval(crump(req.GetStatisticsIntervalSec(), limit.StatisticsIntervalSec))
+	}
+
+	if req.GetExpireUnixNanosec() > 0 {
+		nowNanosec := uint64(time.Now().UnixNano())
+		remainingNanosec := uint64(0)
+		if req.GetExpireUnixNanosec() > nowNanosec {
+			remainingNanosec = req.GetExpireUnixNanosec() - nowNanosec
 		}
-	} else {
-		close(ch)
+		remainingNanosec = crump(remainingNanosec, tValueRange{
+			Min: limit.StopPingerSec.Min * uint64(time.Second),
+			Max: limit.StopPingerSec.Max * uint64(time.Second),
+		})
+		p.setExpireUnixNanosec(nowNanosec + remainingNanosec)
+	}
+	thisServer.publishWithInfo(pb.PingerEvent_PingerEventTypeUpdated, handle)
+
+	return thisServer.info(handle)
+}
+
+func (thisServer *pingerServer) discoverPathMTU(ctx context.Context, req *pb.PathMTURequest) (*pb.PathMTUResult, error) {
+	if targetID, err := pinger46.ResolveTarget(req.GetTargetIP()); err != nil || targetID.ProbeType != pinger46.ProbeTypeICMP {
+		description := "only ICMP target is supported"
+		if err != nil {
+			description = err.Error()
+		}
+		return nil, errInvalidArgument("invalid target", []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       "TargetIP",
+				Description: description,
+			},
+		})
+	}
+
+	limit := thisServer.config.Limit
+	maxPayloadSize := limit.PayloadSize.Max
+	if req.GetMaxPayloadSize() > 0 {
+		maxPayloadSize = crump(req.GetMaxPayloadSize(), limit.PayloadSize)
+	}
+	config := pinger46.PathMTUConfig{
+		SourceIPAddress:   thisServer.config.ICMPSourceIPAddress,
+		SourceIPv6Address: thisServer.config.ICMPv6SourceIPAddress,
+		MinPayloadSize:    int(crump(req.GetMinPayloadSize(), limit.PayloadSize)),
+		MaxPayloadSize:    int(maxPayloadSize),
+		TimeoutMillisec:   int64(crump(req.GetTimeoutMillisec(), limit.TimeoutMillisec)),
+		Attempts:          int(crump(req.GetAttempts(), limit.PathMTUAttempts)),
+	}
+
+	//ICMP識別子がpingerと被らないように
+	id, err := thisServer.pingers.reserveIcmpID()
+	if err != nil {
+		return nil, err
+	}
+	defer thisServer.pingers.releaseIcmpID(id)
+
+	res, err := pinger46.DiscoverPathMTU(ctx, int(id), req.GetTargetIP(), config)
+	if err != nil {
+		logger.Log(labelinglog.FlgWarn, "DiscoverPathMTU fail : "+err.Error())
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		return nil, errInternal("DiscoverPathMTU fail : " + err.Error())
+	}
+
+	fragmentationNeededs := make([]*pb.PathMTUResult_FragmentationNeeded, 0, len(res.FragmentationNeeded))
+	for _, fragmentationNeeded := range res.FragmentationNeeded {
+		fragmentationNeededs = append(fragmentationNeededs, &pb.PathMTUResult_FragmentationNeeded{
+			BinPeerIP:    pinger46.BinIPAddress2BinIPv4(fragmentationNeeded.BinPeerIP),
+			BinPeerIP128: pinger46.BinIPAddress2Bytes(fragmentationNeeded.BinPeerIP),
+			NextHopMTU:   uint64(fragmentationNeeded.NextHopMTU),
+			PayloadSize:  uint64(fragmentationNeeded.PayloadSize),
+		})
 	}
 
-	return ch
+	return &pb.PathMTUResult{
+		TargetID:             pinger46.BinIPAddress2BinIPv4(res.TargetBinIP),
+		TargetID128:          pinger46.BinIPAddress2Bytes(res.TargetBinIP),
+		TargetBinIP:          pinger46.BinIPAddress2String(res.TargetBinIP),
+		MaxPayloadSize:       uint64(res.MaxPayloadSize),
+		PathMTU:              uint64(res.PathMTU),
+		ProbeCount:           uint64(res.ProbeCount),
+		FragmentationNeededs: fragmentationNeededs,
+	}, nil
 }
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/umenosuke/ping-grpc-server/pinger46"
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+type tTermination struct {
+	sync.Mutex
+	reason         pb.TerminationReason
+	stoppedBy      string
+	err            string
+	endUnixNanosec uint64
+	//pinger.Runが返ったら閉じる、それまでは理由も集計も確定しない
+	chRunDone chan struct{}
+	summary   struct {
+		once  sync.Once
+		value *pb.PingerSummary
+	}
+}
+
+func newTermination() *tTermination {
+	return &tTermination{
+		chRunDone: make(chan struct{}),
+	}
+}
+
+//最初に記録された理由を使う
+func (thisTermination *tTermination) record(reason pb.TerminationReason, stoppedBy string, err string) {
+	thisTermination.Lock()
+	defer thisTermination.Unlock()
+
+	if thisTermination.reason != pb.TerminationReason_TerminationReasonUnknown {
+		return
+	}
+	thisTermination.reason = reason
+	thisTermination.stoppedBy = stoppedBy
+	thisTermination.err = err
+}
+
+//理由を記録してから止める
+func (thisPingerWrap *tPingerWrap) stop(reason pb.TerminationReason, stoppedBy string) {
+	thisPingerWrap.termination.record(reason, stoppedBy, "")
+	thisPingerWrap.cancelFunc()
+}
+
+//pinger.Runの戻り値から理由を決める、誰も記録していなければ親のctxが止められた
+func (thisPingerWrap *tPingerWrap) runDone(err error) {
+	termination := thisPingerWrap.termination
+	if thisPingerWrap.pinger.IsCompleted() {
+		termination.record(pb.TerminationReason_TerminationReasonCompleted, "", "")
+	} else if err != nil {
+		termination.record(pb.TerminationReason_TerminationReasonFailed, "", err.Error())
+	} else {
+		termination.record(pb.TerminationReason_TerminationReasonServerShutdown, "", "")
+	}
+
+	termination.Lock()
+	termination.endUnixNanosec = uint64(time.Now().UnixNano())
+	termination.Unlock()
+
+	close(termination.chRunDone)
+}
+
+//pinger.Runが返るまで待ってから作る、全ストリームで同じものを返す
+func (thisPingerWrap *tPingerWrap) getSummary() *pb.PingerSummary {
+	termination := thisPingerWrap.termination
+	<-termination.chRunDone
+
+	termination.summary.once.Do(func() {
+		totals := thisPingerWrap.pinger.GetProbeTotals()
+		info := thisPingerWrap.pinger.GetInfo()
+		pbTargets := make([]*pb.PingerSummary_TargetTotal, 0, len(info.TargetsOrder))
+		for _, id := range info.TargetsOrder {
+			total := totals[id]
+			pbTarget := &pb.PingerSummary_TargetTotal{
+				TargetID:      pinger46.BinIPAddress2BinIPv4(id.BinIP),
+				TargetID128:   pinger46.BinIPAddress2Bytes(id.BinIP),
+				ProbeType:     probeType2pb(id.ProbeType),
+				Port:          uint32(id.Port),
+				SentCount:     uint64(total.SentCount),
+				ReceivedCount: uint64(total.ReceivedCount),
+			}
+			if target, ok := info.Targets[id]; ok {
+				pbTarget.TargetIP = target.IPAddress
+			}
+			if total.SentCount > 0 {
+				pbTarget.LossPercent = float64(total.SentCount-total.ReceivedCount) * 100 / float64(total.SentCount)
+			}
+			pbTargets = append(pbTargets, pbTarget)
+		}
+
+		termination.Lock()
+		defer termination.Unlock()
+		termination.summary.value = &pb.PingerSummary{
+			Handle:           thisPingerWrap.idStr,
+			Reason:           termination.reason,
+			StoppedBy:        termination.stoppedBy,
+			Error:            termination.err,
+			StartUnixNanosec: thisPingerWrap.startUnixNanosec,
+			EndUnixNanosec:   termination.endUnixNanosec,
+			Targets:          pbTargets,
+		}
+	})
+
+	return termination.summary.value
+}
+
+//クライアント証明書があればCNも付ける
+func peerName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	name := p.Addr.String()
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+		name = tlsInfo.State.PeerCertificates[0].Subject.CommonName + "(" + name + ")"
+	}
+
+	return name
+}
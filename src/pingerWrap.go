@@ -3,30 +3,61 @@ package main
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/umenosuke/labelinglog"
-	"github.com/umenosuke/pinger4"
 
+	"github.com/umenosuke/ping-grpc-server/pinger46"
 	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
 )
 
 type tPingerWrap struct {
-	pinger            *pinger4.Pinger
-	idStr             string
-	startUnixNanosec  uint64
-	expireUnixNanosec uint64
-	description       string
-	cancelFunc        context.CancelFunc
-	chResultListener  struct {
-		sync.Mutex
-		list []chan<- *pb.IcmpResult
+	pinger                *pinger46.Pinger
+	idStr                 string
+	startUnixNanosec      uint64
+	expireUnixNanosec     uint64
+	description           string
+	cancelFunc            context.CancelFunc
+	chResultListener      tStreamListeners
+	chStatisticsListener  tStreamListeners
+	chHopTableListener    tStreamListeners
+	chTargetStateListener tStreamListeners
+	statisticsInterval    uint64
+	chExpireChanged       chan struct{}
+	startTargetResults    []*pb.TargetsResponse_TargetResult
+	termination           *tTermination
+	replayBufferSize      uint64
+	//対象が変わったら、pingerからの購読のチャネルを対象の数に合わせて大きくする
+	chTargetsChanged tTargetsChanged
+	//chResultListenerのロックで保護
+	resultReplay *tReplayRing
+	//chStatisticsListenerのロックで保護
+	statisticsReplay *tReplayRing
+}
+
+//購読のチャネルを読んでいるgoroutineごとに知らせる
+type tTargetsChanged struct {
+	result      chan struct{}
+	hopTable    chan struct{}
+	targetState chan struct{}
+}
+
+func newTargetsChanged() tTargetsChanged {
+	return tTargetsChanged{
+		result:      make(chan struct{}, 1),
+		hopTable:    make(chan struct{}, 1),
+		targetState: make(chan struct{}, 1),
 	}
-	chStatisticsListener struct {
-		sync.Mutex
-		list []chan<- *pb.Statistics
+}
+
+func (thisTargetsChanged tTargetsChanged) notify() {
+	for _, ch := range []chan struct{}{thisTargetsChanged.result, thisTargetsChanged.hopTable, thisTargetsChanged.targetState} {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
 	}
-	statisticsInterval uint64
 }
 
 func (thisPingerWrap *tPingerWrap) start(ctx context.Context) {
@@ -39,7 +70,7 @@ func (thisPingerWrap *tPingerWrap) start(ctx context.Context) {
 		defer logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" finish PingerWrap Run")
 		logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" Start PingerWrap Run")
 
-		thisPingerWrap.pinger.Run(ctx)
+		thisPingerWrap.runDone(thisPingerWrap.pinger.Run(ctx))
 	})()
 
 	wgChild.Add(1)
@@ -58,129 +89,448 @@ func (thisPingerWrap *tPingerWrap) start(ctx context.Context) {
 		thisPingerWrap.statistics(ctx)
 	})()
 
+	wgChild.Add(1)
+	go (func() {
+		defer wgChild.Done()
+		defer logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" finish PingerWrap hopTable")
+		logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" Start PingerWrap hopTable")
+		thisPingerWrap.hopTable(ctx)
+	})()
+
+	wgChild.Add(1)
+	go (func() {
+		defer wgChild.Done()
+		defer logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" finish PingerWrap targetState")
+		logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" Start PingerWrap targetState")
+		thisPingerWrap.targetState(ctx)
+	})()
+
 	wgChild.Wait()
 }
 
-func (thisPingerWrap *tPingerWrap) addResultListener(ch chan<- *pb.IcmpResult) {
+func (thisPingerWrap *tPingerWrap) getStatisticsInterval() uint64 {
+	return atomic.LoadUint64(&thisPingerWrap.statisticsInterval)
+}
+
+func (thisPingerWrap *tPingerWrap) setStatisticsInterval(statisticsInterval uint64) {
+	atomic.StoreUint64(&thisPingerWrap.statisticsInterval, statisticsInterval)
+}
+
+func (thisPingerWrap *tPingerWrap) getExpireUnixNanosec() uint64 {
+	return atomic.LoadUint64(&thisPingerWrap.expireUnixNanosec)
+}
+
+func (thisPingerWrap *tPingerWrap) setExpireUnixNanosec(expireUnixNanosec uint64) {
+	atomic.StoreUint64(&thisPingerWrap.expireUnixNanosec, expireUnixNanosec)
+	select {
+	case thisPingerWrap.chExpireChanged <- struct{}{}:
+	default:
+	}
+}
+
+func (thisPingerWrap *tPingerWrap) addResultListener(listener *tStreamListener, fromSequence uint64, fromUnixNanosec uint64) {
 	thisPingerWrap.chResultListener.Lock()
 	defer thisPingerWrap.chResultListener.Unlock()
-	if thisPingerWrap.chResultListener.list != nil {
-		thisPingerWrap.chResultListener.list = append(thisPingerWrap.chResultListener.list, ch)
-	} else {
-		close(ch)
-	}
+	replay, missedCount := thisPingerWrap.resultReplay.since(fromSequence, fromUnixNanosec)
+	thisPingerWrap.chResultListener.addWithoutLock(listener, replay, missedCount)
 }
 
 func (thisPingerWrap *tPingerWrap) result(ctx context.Context) {
 	defer thisPingerWrap.cancelFunc()
 	defer logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" finish pinger.GetChIcmpResult")
-	defer (func() {
-		thisPingerWrap.chResultListener.Lock()
-		defer thisPingerWrap.chResultListener.Unlock()
-		for _, ch := range thisPingerWrap.chResultListener.list {
-			close(ch)
-		}
-		thisPingerWrap.chResultListener.list = nil
-	})()
+	defer thisPingerWrap.chResultListener.closeAll()
 	logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" Start pinger.GetChIcmpResult")
 
-	targetsOrder := thisPingerWrap.pinger.GetInfo().TargetsOrder
-	chIcmpResult := thisPingerWrap.pinger.GetChIcmpResult(len(targetsOrder) * 2)
+	chIcmpResult := thisPingerWrap.pinger.GetChIcmpResult(len(thisPingerWrap.pinger.GetTargetsOrder()) * 2)
 	for {
 		select {
 		case <-ctx.Done():
-			return
-		case result := <-chIcmpResult:
-			var resType pb.IcmpResult_ResultType
-			switch result.ResultType {
-			case pinger4.IcmpResultTypeReceive:
-				resType = pb.IcmpResult_IcmpResultTypeReceive
-			case pinger4.IcmpResultTypeReceiveAfterTimeout:
-				resType = pb.IcmpResult_IcmpResultTypeReceiveAfterTimeout
-			case pinger4.IcmpResultTypeTTLExceeded:
-				resType = pb.IcmpResult_IcmpResultTypeTTLExceeded
-			case pinger4.IcmpResultTypeTimeout:
-				resType = pb.IcmpResult_IcmpResultTypeTimeout
-			default:
-				resType = pb.IcmpResult_IcmpResultTypeUnknown
-			}
-			pbResult := pb.IcmpResult{
-				Type:                   resType,
-				TargetID:               uint32(result.IcmpTargetID),
-				BinPeerIP:              uint32(result.BinPeerIP),
-				Sequence:               int64(result.Seq),
-				SendTimeUnixNanosec:    int64(result.SendTimeUnixNanosec),
-				ReceiveTimeUnixNanosec: int64(result.ReceiveTimeUnixNanosec),
-			}
-
+			//溜まっている結果を流し切ってから最後にまとめを送る
+			<-thisPingerWrap.termination.chRunDone
 			(func() {
-				thisPingerWrap.chResultListener.Lock()
-				defer thisPingerWrap.chResultListener.Unlock()
-				for _, ch := range thisPingerWrap.chResultListener.list {
+				for {
 					select {
-					case ch <- &pbResult:
+					case result := <-chIcmpResult:
+						thisPingerWrap.sendResult(result)
 					default:
+						return
 					}
 				}
 			})()
+			pbResult := &pb.IcmpResult{
+				Type:    pb.IcmpResult_IcmpResultTypeSummary,
+				Summary: thisPingerWrap.getSummary(),
+			}
+
+			thisPingerWrap.chResultListener.Lock()
+			defer thisPingerWrap.chResultListener.Unlock()
+			pbResult.StreamSequence = thisPingerWrap.resultReplay.push(pbResult)
+			thisPingerWrap.chResultListener.sendFinalWithoutLock(pbResult)
+			return
+		case result := <-chIcmpResult:
+			thisPingerWrap.sendResult(result)
+		case <-thisPingerWrap.chTargetsChanged.result:
+			//古いチャネルにはもう送られないので、残りを流してから入れ替える
+			newChIcmpResult := thisPingerWrap.pinger.ResizeChIcmpResult(chIcmpResult, len(thisPingerWrap.pinger.GetTargetsOrder())*2)
+			for len(chIcmpResult) > 0 {
+				thisPingerWrap.sendResult(<-chIcmpResult)
+			}
+			chIcmpResult = newChIcmpResult
 		}
 	}
 }
 
-func (thisPingerWrap *tPingerWrap) addStatisticsListener(ch chan<- *pb.Statistics) {
+func (thisPingerWrap *tPingerWrap) sendResult(result pinger46.IcmpResult) {
+	pbResult := pb.IcmpResult{
+		Type:                   resultType2pb(result.ResultType),
+		TargetID:               pinger46.BinIPAddress2BinIPv4(result.IcmpTargetID.BinIP),
+		BinPeerIP:              pinger46.BinIPAddress2BinIPv4(result.BinPeerIP),
+		Sequence:               int64(result.Seq),
+		SendTimeUnixNanosec:    int64(result.SendTimeUnixNanosec),
+		ReceiveTimeUnixNanosec: int64(result.ReceiveTimeUnixNanosec),
+		TargetID128:            pinger46.BinIPAddress2Bytes(result.IcmpTargetID.BinIP),
+		ProbeType:              probeType2pb(result.IcmpTargetID.ProbeType),
+		Port:                   uint32(result.IcmpTargetID.Port),
+		TTL:                    uint32(result.TTL),
+	}
+	if result.BinPeerIP != (pinger46.BinIPAddress{}) {
+		pbResult.BinPeerIP128 = pinger46.BinIPAddress2Bytes(result.BinPeerIP)
+	}
+
+	thisPingerWrap.chResultListener.Lock()
+	defer thisPingerWrap.chResultListener.Unlock()
+	drops := thisPingerWrap.pinger.GetDropCounts()
+	thisPingerWrap.chResultListener.setPingerDroppedCountWithoutLock(drops.Result + drops.ResultSubscriber)
+	pbResult.StreamSequence = thisPingerWrap.resultReplay.push(&pbResult)
+	thisPingerWrap.chResultListener.sendWithoutLock(&pbResult)
+}
+
+func (thisPingerWrap *tPingerWrap) addStatisticsListener(listener *tStreamListener, fromSequence uint64, fromUnixNanosec uint64) {
 	thisPingerWrap.chStatisticsListener.Lock()
 	defer thisPingerWrap.chStatisticsListener.Unlock()
-	if thisPingerWrap.chStatisticsListener.list != nil {
-		thisPingerWrap.chStatisticsListener.list = append(thisPingerWrap.chStatisticsListener.list, ch)
-	} else {
-		close(ch)
-	}
+	replay, missedCount := thisPingerWrap.statisticsReplay.since(fromSequence, fromUnixNanosec)
+	thisPingerWrap.chStatisticsListener.addWithoutLock(listener, replay, missedCount)
 }
 
 func (thisPingerWrap *tPingerWrap) statistics(ctx context.Context) {
 	defer thisPingerWrap.cancelFunc()
 	defer logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" finish pinger.GetStatistics")
-	defer (func() {
-		thisPingerWrap.chStatisticsListener.Lock()
-		defer thisPingerWrap.chStatisticsListener.Unlock()
-		for _, ch := range thisPingerWrap.chStatisticsListener.list {
-			close(ch)
-		}
-		thisPingerWrap.chStatisticsListener.list = nil
-	})()
+	defer thisPingerWrap.chStatisticsListener.closeAll()
 	logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" Start pinger.GetStatistics")
 
-	interval := time.Duration(thisPingerWrap.statisticsInterval) * time.Second
-
-	targetsOrder := thisPingerWrap.pinger.GetInfo().TargetsOrder
 	for {
+		interval := time.Duration(thisPingerWrap.getStatisticsInterval()) * time.Second
 		select {
 		case <-ctx.Done():
+			//最後の集計とまとめを確実に送る
+			summary := thisPingerWrap.getSummary()
+			pbStatistics := thisPingerWrap.statisticsSnapshot()
+			pbStatistics.Final = true
+			pbStatistics.Summary = summary
+
+			thisPingerWrap.chStatisticsListener.Lock()
+			defer thisPingerWrap.chStatisticsListener.Unlock()
+			pbStatistics.StreamSequence = thisPingerWrap.statisticsReplay.push(pbStatistics)
+			thisPingerWrap.chStatisticsListener.sendFinalWithoutLock(pbStatistics)
 			return
 		case <-time.After(interval):
-			counts := thisPingerWrap.pinger.GetSuccessCounts()
-			pbCounts := make([]*pb.Statistics_SuccessCount, 0)
-			for _, id := range targetsOrder {
-				pbCounts = append(pbCounts, &pb.Statistics_SuccessCount{
-					TargetID: uint32(id),
-					Count:    counts[id].Count,
-				})
-			}
-
-			pbStatistics := &pb.Statistics{
-				Targets: pbCounts,
-			}
+			pbStatistics := thisPingerWrap.statisticsSnapshot()
 
 			(func() {
 				thisPingerWrap.chStatisticsListener.Lock()
 				defer thisPingerWrap.chStatisticsListener.Unlock()
-				for _, ch := range thisPingerWrap.chStatisticsListener.list {
-					select {
-					case ch <- pbStatistics:
-					default:
+				pbStatistics.StreamSequence = thisPingerWrap.statisticsReplay.push(pbStatistics)
+				thisPingerWrap.chStatisticsListener.sendWithoutLock(pbStatistics)
+			})()
+		}
+	}
+}
+
+func (thisPingerWrap *tPingerWrap) statisticsSnapshot() *pb.Statistics {
+	targetsOrder := thisPingerWrap.pinger.GetTargetsOrder()
+	counts := thisPingerWrap.pinger.GetSuccessCounts()
+	pbCounts := make([]*pb.Statistics_SuccessCount, 0)
+	for _, id := range targetsOrder {
+		count, ok := counts[id]
+		if !ok {
+			continue
+		}
+		pbCounts = append(pbCounts, &pb.Statistics_SuccessCount{
+			TargetID:         pinger46.BinIPAddress2BinIPv4(id.BinIP),
+			Count:            count.Count,
+			TargetID128:      pinger46.BinIPAddress2Bytes(id.BinIP),
+			ProbeType:        probeType2pb(id.ProbeType),
+			Port:             uint32(id.Port),
+			LossPercent:      count.LossPercent,
+			RttCount:         count.RttCount,
+			MinRttNanosec:    count.MinRttNanosec,
+			AvgRttNanosec:    count.AvgRttNanosec,
+			MaxRttNanosec:    count.MaxRttNanosec,
+			StddevRttNanosec: count.StddevRttNanosec,
+			P50RttNanosec:    count.P50RttNanosec,
+			P95RttNanosec:    count.P95RttNanosec,
+			P99RttNanosec:    count.P99RttNanosec,
+			JitterNanosec:    count.JitterNanosec,
+			Windows:          windows2pb(count.Windows),
+		})
+	}
+
+	return &pb.Statistics{
+		Targets: pbCounts,
+	}
+}
+
+func (thisPingerWrap *tPingerWrap) addHopTableListener(listener *tStreamListener) {
+	thisPingerWrap.chHopTableListener.Lock()
+	defer thisPingerWrap.chHopTableListener.Unlock()
+	thisPingerWrap.chHopTableListener.addWithoutLock(listener, nil, 0)
+}
+
+func (thisPingerWrap *tPingerWrap) hopTable(ctx context.Context) {
+	defer thisPingerWrap.cancelFunc()
+	defer logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" finish pinger.GetHopTable")
+	defer thisPingerWrap.chHopTableListener.closeAll()
+	logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" Start pinger.GetHopTable")
+
+	chPathChange := thisPingerWrap.pinger.GetChPathChange(len(thisPingerWrap.pinger.GetTargetsOrder()))
+	for {
+		interval := time.Duration(thisPingerWrap.getStatisticsInterval()) * time.Second
+		var pbHopTable *pb.HopTable
+		select {
+		case <-ctx.Done():
+			pbHopTable = &pb.HopTable{
+				Type:    pb.HopTable_HopTableTypeSummary,
+				Summary: thisPingerWrap.getSummary(),
+			}
+
+			thisPingerWrap.chHopTableListener.Lock()
+			defer thisPingerWrap.chHopTableListener.Unlock()
+			thisPingerWrap.chHopTableListener.sendFinalWithoutLock(pbHopTable)
+			return
+		case change := <-chPathChange:
+			pbHopTable = pathChange2pb(change)
+		case <-thisPingerWrap.chTargetsChanged.hopTable:
+			//古いチャネルにはもう送られないので、残りを流してから入れ替える
+			newChPathChange := thisPingerWrap.pinger.ResizeChPathChange(chPathChange, len(thisPingerWrap.pinger.GetTargetsOrder()))
+			for len(chPathChange) > 0 {
+				thisPingerWrap.sendHopTable(pathChange2pb(<-chPathChange))
+			}
+			chPathChange = newChPathChange
+			continue
+		case <-time.After(interval):
+			table := thisPingerWrap.pinger.GetHopTable()
+			pbTargets := make([]*pb.HopTable_Target, 0)
+			for _, id := range thisPingerWrap.pinger.GetTargetsOrder() {
+				hops, ok := table[id]
+				if !ok {
+					continue
+				}
+				pbHops := make([]*pb.HopTable_Hop, 0, len(hops))
+				for _, hop := range hops {
+					pbHop := &pb.HopTable_Hop{
+						TTL:            uint32(hop.TTL),
+						BinPeerIP:      pinger46.BinIPAddress2BinIPv4(hop.BinPeerIP),
+						SentCount:      hop.SentCount,
+						ReceivedCount:  hop.ReceivedCount,
+						LastResultType: resultType2pb(hop.LastResultType),
+						LastRttNanosec: hop.LastRttNanosec,
+						MinRttNanosec:  hop.MinRttNanosec,
+						AvgRttNanosec:  hop.AvgRttNanosec,
+						MaxRttNanosec:  hop.MaxRttNanosec,
 					}
+					if hop.BinPeerIP != (pinger46.BinIPAddress{}) {
+						pbHop.BinPeerIP128 = pinger46.BinIPAddress2Bytes(hop.BinPeerIP)
+					}
+					pbHops = append(pbHops, pbHop)
 				}
-			})()
+				pbTargets = append(pbTargets, &pb.HopTable_Target{
+					TargetID:    pinger46.BinIPAddress2BinIPv4(id.BinIP),
+					TargetID128: pinger46.BinIPAddress2Bytes(id.BinIP),
+					ProbeType:   probeType2pb(id.ProbeType),
+					Port:        uint32(id.Port),
+					Hops:        pbHops,
+				})
+			}
+			pbHopTable = &pb.HopTable{
+				Type:    pb.HopTable_HopTableTypeTable,
+				Targets: pbTargets,
+			}
+		}
+
+		thisPingerWrap.sendHopTable(pbHopTable)
+	}
+}
+
+func (thisPingerWrap *tPingerWrap) sendHopTable(pbHopTable *pb.HopTable) {
+	thisPingerWrap.chHopTableListener.Lock()
+	defer thisPingerWrap.chHopTableListener.Unlock()
+	thisPingerWrap.chHopTableListener.setPingerDroppedCountWithoutLock(thisPingerWrap.pinger.GetDropCounts().PathChangeSubscriber)
+	thisPingerWrap.chHopTableListener.sendWithoutLock(pbHopTable)
+}
+
+func pathChange2pb(change pinger46.PathChange) *pb.HopTable {
+	return &pb.HopTable{
+		Type: pb.HopTable_HopTableTypePathChange,
+		Change: &pb.HopTable_PathChange{
+			TargetID:              pinger46.BinIPAddress2BinIPv4(change.IcmpTargetID.BinIP),
+			TargetID128:           pinger46.BinIPAddress2Bytes(change.IcmpTargetID.BinIP),
+			ProbeType:             probeType2pb(change.IcmpTargetID.ProbeType),
+			Port:                  uint32(change.IcmpTargetID.Port),
+			TTL:                   uint32(change.TTL),
+			OldBinPeerIP:          pinger46.BinIPAddress2BinIPv4(change.OldBinPeerIP),
+			OldBinPeerIP128:       pinger46.BinIPAddress2Bytes(change.OldBinPeerIP),
+			NewBinPeerIP:          pinger46.BinIPAddress2BinIPv4(change.NewBinPeerIP),
+			NewBinPeerIP128:       pinger46.BinIPAddress2Bytes(change.NewBinPeerIP),
+			ChangeTimeUnixNanosec: change.ChangeTimeUnixNanosec,
+		},
+	}
+}
+
+func (thisPingerWrap *tPingerWrap) addTargetStateListener(listener *tStreamListener) {
+	thisPingerWrap.chTargetStateListener.Lock()
+	defer thisPingerWrap.chTargetStateListener.Unlock()
+	thisPingerWrap.chTargetStateListener.addWithoutLock(listener, nil, 0)
+}
+
+func (thisPingerWrap *tPingerWrap) targetState(ctx context.Context) {
+	defer thisPingerWrap.cancelFunc()
+	defer logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" finish pinger.GetChTargetStateChange")
+	defer thisPingerWrap.chTargetStateListener.closeAll()
+	logger.Log(labelinglog.FlgDebug, "(id "+thisPingerWrap.idStr+")"+" Start pinger.GetChTargetStateChange")
+
+	chTargetStateChange := thisPingerWrap.pinger.GetChTargetStateChange(len(thisPingerWrap.pinger.GetTargetsOrder()) * 2)
+	for {
+		select {
+		case <-ctx.Done():
+			pbChange := &pb.TargetStateChange{
+				Summary: thisPingerWrap.getSummary(),
+			}
+
+			thisPingerWrap.chTargetStateListener.Lock()
+			defer thisPingerWrap.chTargetStateListener.Unlock()
+			thisPingerWrap.chTargetStateListener.sendFinalWithoutLock(pbChange)
+			return
+		case change := <-chTargetStateChange:
+			thisPingerWrap.sendTargetStateChange(change)
+		case <-thisPingerWrap.chTargetsChanged.targetState:
+			//古いチャネルにはもう送られないので、残りを流してから入れ替える
+			newChTargetStateChange := thisPingerWrap.pinger.ResizeChTargetStateChange(chTargetStateChange, len(thisPingerWrap.pinger.GetTargetsOrder())*2)
+			for len(chTargetStateChange) > 0 {
+				thisPingerWrap.sendTargetStateChange(<-chTargetStateChange)
+			}
+			chTargetStateChange = newChTargetStateChange
 		}
 	}
 }
+
+func (thisPingerWrap *tPingerWrap) sendTargetStateChange(change pinger46.TargetStateChange) {
+	pbChange := &pb.TargetStateChange{
+		TargetID:              pinger46.BinIPAddress2BinIPv4(change.IcmpTargetID.BinIP),
+		TargetID128:           pinger46.BinIPAddress2Bytes(change.IcmpTargetID.BinIP),
+		ProbeType:             probeType2pb(change.IcmpTargetID.ProbeType),
+		Port:                  uint32(change.IcmpTargetID.Port),
+		OldState:              targetState2pb(change.OldState),
+		NewState:              targetState2pb(change.NewState),
+		ChangeTimeUnixNanosec: change.ChangeTimeUnixNanosec,
+		LastRttNanosec:        change.LastRttNanosec,
+		LossPercent:           change.LossPercent,
+	}
+
+	thisPingerWrap.chTargetStateListener.Lock()
+	defer thisPingerWrap.chTargetStateListener.Unlock()
+	thisPingerWrap.chTargetStateListener.setPingerDroppedCountWithoutLock(thisPingerWrap.pinger.GetDropCounts().TargetStateSubscriber)
+	thisPingerWrap.chTargetStateListener.sendWithoutLock(pbChange)
+}
+
+func probeType2pb(probeType pinger46.ProbeType) pb.ProbeType {
+	switch probeType {
+	case pinger46.ProbeTypeTCP:
+		return pb.ProbeType_ProbeTypeTCP
+	case pinger46.ProbeTypeUDP:
+		return pb.ProbeType_ProbeTypeUDP
+	default:
+		return pb.ProbeType_ProbeTypeICMP
+	}
+}
+
+func mode2pb(mode pinger46.Mode) pb.PingerMode {
+	switch mode {
+	case pinger46.ModeTrace:
+		return pb.PingerMode_PingerModeTrace
+	default:
+		return pb.PingerMode_PingerModePing
+	}
+}
+
+func targetState2pb(state pinger46.TargetState) pb.TargetState {
+	switch state {
+	case pinger46.TargetStateUp:
+		return pb.TargetState_TargetStateUp
+	case pinger46.TargetStateDegraded:
+		return pb.TargetState_TargetStateDegraded
+	case pinger46.TargetStateDown:
+		return pb.TargetState_TargetStateDown
+	default:
+		return pb.TargetState_TargetStateUnknown
+	}
+}
+
+func resultType2pb(resultType pinger46.IcmpResultType) pb.IcmpResult_ResultType {
+	switch resultType {
+	case pinger46.IcmpResultTypeReceive:
+		return pb.IcmpResult_IcmpResultTypeReceive
+	case pinger46.IcmpResultTypeReceiveAfterTimeout:
+		return pb.IcmpResult_IcmpResultTypeReceiveAfterTimeout
+	case pinger46.IcmpResultTypeTTLExceeded:
+		return pb.IcmpResult_IcmpResultTypeTTLExceeded
+	case pinger46.IcmpResultTypeTimeout:
+		return pb.IcmpResult_IcmpResultTypeTimeout
+	case pinger46.IcmpResultTypeRefused:
+		return pb.IcmpResult_IcmpResultTypeRefused
+	case pinger46.IcmpResultTypePortUnreachable:
+		return pb.IcmpResult_IcmpResultTypePortUnreachable
+	case pinger46.IcmpResultTypeNetUnreachable:
+		return pb.IcmpResult_IcmpResultTypeNetUnreachable
+	case pinger46.IcmpResultTypeHostUnreachable:
+		return pb.IcmpResult_IcmpResultTypeHostUnreachable
+	case pinger46.IcmpResultTypeProtocolUnreachable:
+		return pb.IcmpResult_IcmpResultTypeProtocolUnreachable
+	case pinger46.IcmpResultTypeAdminProhibited:
+		return pb.IcmpResult_IcmpResultTypeAdminProhibited
+	case pinger46.IcmpResultTypeFragmentationNeeded:
+		return pb.IcmpResult_IcmpResultTypeFragmentationNeeded
+	case pinger46.IcmpResultTypeDestinationUnreachable:
+		return pb.IcmpResult_IcmpResultTypeDestinationUnreachable
+	case pinger46.IcmpResultTypeSourceQuench:
+		return pb.IcmpResult_IcmpResultTypeSourceQuench
+	case pinger46.IcmpResultTypeRedirect:
+		return pb.IcmpResult_IcmpResultTypeRedirect
+	case pinger46.IcmpResultTypeParameterProblem:
+		return pb.IcmpResult_IcmpResultTypeParameterProblem
+	default:
+		return pb.IcmpResult_IcmpResultTypeUnknown
+	}
+}
+
+func windows2pb(windows []pinger46.WindowStatistics) []*pb.Statistics_SuccessCount_Window {
+	res := make([]*pb.Statistics_SuccessCount_Window, 0, len(windows))
+	for _, window := range windows {
+		res = append(res, &pb.Statistics_SuccessCount_Window{
+			WindowSec:        uint64(window.WindowSec),
+			SentCount:        window.SentCount,
+			ReceivedCount:    window.ReceivedCount,
+			LossPercent:      window.LossPercent,
+			RttCount:         window.RttCount,
+			MinRttNanosec:    window.MinRttNanosec,
+			AvgRttNanosec:    window.AvgRttNanosec,
+			MaxRttNanosec:    window.MaxRttNanosec,
+			StddevRttNanosec: window.StddevRttNanosec,
+			P50RttNanosec:    window.P50RttNanosec,
+			P95RttNanosec:    window.P95RttNanosec,
+			P99RttNanosec:    window.P99RttNanosec,
+			JitterNanosec:    window.JitterNanosec,
+		})
+	}
+
+	return res
+}
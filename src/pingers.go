@@ -2,38 +2,113 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	mathRand "math/rand"
 	"sync"
+
+	"github.com/umenosuke/ping-grpc-server/pinger46"
 )
 
 type tPingers struct {
 	sync.Mutex
-	list map[uint16]*tPingersEntry
+	list    map[string]*tPingersEntry
+	icmpIDs map[uint16]struct{}
 }
 type tPingersEntry struct {
 	ctxStartWait         context.Context
 	ctxStartWaitDoneFunc context.CancelFunc
+	icmpID               uint16
 	entry                *tPingerWrap
 }
 
-func (thisPingers *tPingers) addPinger(id uint16, pinger *tPingersEntry) {
+//handleとICMP識別子を払い出して登録
+func (thisPingers *tPingers) addPinger(pinger *tPingersEntry) (string, error) {
 	thisPingers.Lock()
 	defer thisPingers.Unlock()
 
-	thisPingers.list[id] = pinger
+	icmpID, err := thisPingers.reserveIcmpIDWithoutLock()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		handle, err := newHandle()
+		if err != nil {
+			delete(thisPingers.icmpIDs, icmpID)
+			return "", errInternal("handle generation fail : " + err.Error())
+		}
+
+		if _, ok := thisPingers.list[handle]; !ok {
+			pinger.icmpID = icmpID
+			thisPingers.list[handle] = pinger
+			return handle, nil
+		}
+	}
 }
 
-func (thisPingers *tPingers) getPinger(id uint16) (*tPingersEntry, bool) {
+func (thisPingers *tPingers) getPinger(handle string) (*tPingersEntry, bool) {
 	thisPingers.Lock()
 	defer thisPingers.Unlock()
 
-	pinger, ok := thisPingers.list[id]
+	pinger, ok := thisPingers.list[handle]
 
 	return pinger, ok
 }
 
-func (thisPingers *tPingers) deletePinger(id uint16) {
+func (thisPingers *tPingers) deletePinger(handle string) {
 	thisPingers.Lock()
 	defer thisPingers.Unlock()
 
-	delete(thisPingers.list, id)
+	if pinger, ok := thisPingers.list[handle]; ok {
+		delete(thisPingers.icmpIDs, pinger.icmpID)
+		delete(thisPingers.list, handle)
+	}
+}
+
+//pinger以外(Path MTU探索など)で一時的にICMP識別子を使う用
+func (thisPingers *tPingers) reserveIcmpID() (uint16, error) {
+	thisPingers.Lock()
+	defer thisPingers.Unlock()
+
+	return thisPingers.reserveIcmpIDWithoutLock()
+}
+
+func (thisPingers *tPingers) releaseIcmpID(icmpID uint16) {
+	thisPingers.Lock()
+	defer thisPingers.Unlock()
+
+	delete(thisPingers.icmpIDs, icmpID)
+}
+
+//自分で使っているものとホスト上の他のpingが使っているものを避ける
+//ホスト上の他のpingはpingソケットのものだけ分かる、rawソケットで撃つものは避けられない
+func (thisPingers *tPingers) reserveIcmpIDWithoutLock() (uint16, error) {
+	hostUsed := pinger46.UsedIcmpIDs()
+
+	start := uint16(mathRand.Uint32())
+	for i := 0; i <= 0xffff; i++ {
+		icmpID := start + uint16(i)
+		if _, ok := thisPingers.icmpIDs[icmpID]; ok {
+			continue
+		}
+		if _, ok := hostUsed[int(icmpID)]; ok {
+			continue
+		}
+
+		thisPingers.icmpIDs[icmpID] = struct{}{}
+		return icmpID, nil
+	}
+
+	return 0, errIcmpIDExhausted()
+}
+
+//推測できない使い捨てのID
+func newHandle() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
 }
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"time"
+)
+
+//途中から購読したクライアントに直近の送信分を渡すためのリングバッファ
+//排他はリスナーのロックで行う
+type tReplayRing struct {
+	nextSequence uint64
+	entries      []tReplayEntry
+	head         int
+	size         int
+}
+
+type tReplayEntry struct {
+	sequence    uint64
+	unixNanosec uint64
+	value       interface{}
+}
+
+func newReplayRing(size uint64) *tReplayRing {
+	return &tReplayRing{
+		nextSequence: 1,
+		entries:      make([]tReplayEntry, size),
+	}
+}
+
+//保存してシーケンス番号を返す、サイズ0でも番号は振る
+func (thisRing *tReplayRing) push(value interface{}) uint64 {
+	sequence := thisRing.nextSequence
+	thisRing.nextSequence++
+
+	if len(thisRing.entries) == 0 {
+		return sequence
+	}
+
+	thisRing.entries[(thisRing.head+thisRing.size)%len(thisRing.entries)] = tReplayEntry{
+		sequence:    sequence,
+		unixNanosec: uint64(time.Now().UnixNano()),
+		value:       value,
+	}
+	if thisRing.size < len(thisRing.entries) {
+		thisRing.size++
+	} else {
+		thisRing.head = (thisRing.head + 1) % len(thisRing.entries)
+	}
+
+	return sequence
+}
+
+//指定されたシーケンス番号と時刻の両方以降のものを古い順に返す、両方0なら何も返さない
+//指定されたシーケンス番号から既に押し出された数も返す、時刻だけの指定では数えられないので0
+func (thisRing *tReplayRing) since(fromSequence uint64, fromUnixNanosec uint64) ([]interface{}, uint64) {
+	if fromSequence == 0 && fromUnixNanosec == 0 {
+		return nil, 0
+	}
+
+	evictedCount := uint64(0)
+	if oldestSequence := thisRing.nextSequence - uint64(thisRing.size); fromSequence > 0 && fromSequence < oldestSequence {
+		evictedCount = oldestSequence - fromSequence
+	}
+
+	res := make([]interface{}, 0)
+	for i := 0; i < thisRing.size; i++ {
+		entry := thisRing.entries[(thisRing.head+i)%len(thisRing.entries)]
+		if entry.sequence >= fromSequence && entry.unixNanosec >= fromUnixNanosec {
+			res = append(res, entry.value)
+		}
+	}
+
+	return res, evictedCount
+}
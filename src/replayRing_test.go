@@ -0,0 +1,131 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+func TestReplayRingSince(t *testing.T) {
+	farFutureUnixNanosec := uint64(time.Now().Add(time.Hour).UnixNano())
+
+	tests := []struct {
+		name             string
+		size             uint64
+		pushedNum        int
+		fromSequence     uint64
+		fromUnixNanosec  uint64
+		want             []interface{}
+		wantEvictedCount uint64
+	}{
+		{"nothing requested", 3, 5, 0, 0, nil, 0},
+		{"all kept", 3, 2, 1, 0, []interface{}{1, 2}, 0},
+		{"from the middle", 3, 5, 4, 0, []interface{}{4, 5}, 0},
+		{"evicted", 3, 5, 1, 0, []interface{}{3, 4, 5}, 2},
+		{"from the oldest kept", 3, 5, 3, 0, []interface{}{3, 4, 5}, 0},
+		{"from the next", 3, 5, 6, 0, []interface{}{}, 0},
+		{"size 0 keeps nothing", 0, 3, 1, 0, []interface{}{}, 3},
+		{"time only", 3, 5, 0, 1, []interface{}{3, 4, 5}, 0},
+		{"time in the future", 3, 5, 0, farFutureUnixNanosec, []interface{}{}, 0},
+		{"sequence and time", 3, 5, 1, farFutureUnixNanosec, []interface{}{}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring := newReplayRing(tt.size)
+			for i := 1; i <= tt.pushedNum; i++ {
+				if sequence := ring.push(i); sequence != uint64(i) {
+					t.Fatalf("push() = %d, want %d", sequence, i)
+				}
+			}
+
+			got, evictedCount := ring.since(tt.fromSequence, tt.fromUnixNanosec)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("since() = %v, want %v", got, tt.want)
+			}
+			if evictedCount != tt.wantEvictedCount {
+				t.Errorf("since() evicted = %d, want %d", evictedCount, tt.wantEvictedCount)
+			}
+		})
+	}
+}
+
+func TestStreamListenersAddReplay(t *testing.T) {
+	result := func(sequence uint64) proto.Message {
+		return &pb.IcmpResult{
+			Type:           pb.IcmpResult_IcmpResultTypeReceive,
+			StreamSequence: sequence,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		replay      []interface{}
+		missedCount uint64
+		isClosed    bool
+		want        []proto.Message
+	}{
+		{
+			name:   "replay only",
+			replay: []interface{}{result(3), result(4)},
+			want:   []proto.Message{result(3), result(4)},
+		},
+		{
+			name:        "gap marker first",
+			replay:      []interface{}{result(3), result(4)},
+			missedCount: 2,
+			want:        []proto.Message{newResultGapMarker(2), result(3), result(4)},
+		},
+		{
+			name:        "gap marker without replay",
+			replay:      []interface{}{},
+			missedCount: 5,
+			want:        []proto.Message{newResultGapMarker(5)},
+		},
+		{
+			name:     "already finished",
+			replay:   []interface{}{result(3)},
+			isClosed: true,
+			want:     []proto.Message{result(3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listeners := newStreamListeners()
+			if tt.isClosed {
+				listeners.closeAll()
+			}
+			listener := &tStreamListener{
+				bufferSize:   1,
+				newGapMarker: newResultGapMarker,
+			}
+			listeners.addWithoutLock(listener, tt.replay, tt.missedCount)
+
+			got := make([]proto.Message, 0)
+			for len(listener.ch) > 0 {
+				got = append(got, <-listener.ch)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d messages, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("message %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+
+			if tt.isClosed {
+				if _, ok := <-listener.ch; ok {
+					t.Errorf("channel is open, want closed")
+				}
+			} else if len(listeners.list) != 1 {
+				t.Errorf("listeners = %d, want 1", len(listeners.list))
+			}
+		})
+	}
+}
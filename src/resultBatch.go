@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+//複数の結果を一つのメッセージにまとめる
+//対象は一覧に一度だけ入れて番号で参照し、送信時刻は一つ前の結果からの差、受信時刻は自身の送信時刻からの差にする
+type tResultBatch struct {
+	batch               *pb.IcmpResultBatch
+	targetIndex         map[tResultFilterTarget]uint32
+	lastSendTimeNanosec int64
+}
+
+func newResultBatch() *tResultBatch {
+	return &tResultBatch{
+		batch:       &pb.IcmpResultBatch{},
+		targetIndex: make(map[tResultFilterTarget]uint32),
+	}
+}
+
+func (thisBatch *tResultBatch) add(result *pb.IcmpResult) {
+	switch result.GetType() {
+	case pb.IcmpResult_IcmpResultTypeGap:
+		thisBatch.batch.DroppedCount += result.GetDroppedCount()
+		return
+	case pb.IcmpResult_IcmpResultTypeSummary:
+		thisBatch.batch.Summary = result.GetSummary()
+		return
+	}
+
+	target := tResultFilterTarget{
+		targetID128: string(result.GetTargetID128()),
+		probeType:   result.GetProbeType(),
+		port:        result.GetPort(),
+	}
+	index, ok := thisBatch.targetIndex[target]
+	if !ok {
+		index = uint32(len(thisBatch.batch.Targets))
+		thisBatch.targetIndex[target] = index
+		thisBatch.batch.Targets = append(thisBatch.batch.Targets, &pb.IcmpResultBatch_Target{
+			TargetID:    result.GetTargetID(),
+			TargetID128: result.GetTargetID128(),
+			ProbeType:   result.GetProbeType(),
+			Port:        result.GetPort(),
+		})
+	}
+
+	if len(thisBatch.batch.Results) == 0 {
+		thisBatch.batch.BaseUnixNanosec = result.GetSendTimeUnixNanosec()
+		thisBatch.lastSendTimeNanosec = result.GetSendTimeUnixNanosec()
+	}
+	thisBatch.batch.Results = append(thisBatch.batch.Results, &pb.IcmpResultBatch_Result{
+		Type:                    result.GetType(),
+		TargetIndex:             index,
+		Sequence:                result.GetSequence(),
+		SendTimeDeltaNanosec:    result.GetSendTimeUnixNanosec() - thisBatch.lastSendTimeNanosec,
+		ReceiveTimeDeltaNanosec: result.GetReceiveTimeUnixNanosec() - result.GetSendTimeUnixNanosec(),
+		BinPeerIP128:            result.GetBinPeerIP128(),
+		TTL:                     result.GetTTL(),
+		StreamSequence:          result.GetStreamSequence(),
+	})
+	thisBatch.lastSendTimeNanosec = result.GetSendTimeUnixNanosec()
+}
+
+func (thisBatch *tResultBatch) size() int {
+	return len(thisBatch.batch.Results)
+}
+
+func (thisBatch *tResultBatch) isEmpty() bool {
+	return len(thisBatch.batch.Results) == 0 && thisBatch.batch.DroppedCount == 0 && thisBatch.batch.Summary == nil
+}
+
+//結果の数か間隔のどちらかに達したら送る、最後のまとめは溜まっている分と一緒にすぐ送る
+func (thisServer *pingerServer) sendIcmpResultBatch(ctx context.Context, listener *tStreamListener, req *pb.BatchStreamRequest, send func(*pb.IcmpResultBatch) error) error {
+	limit := thisServer.config.Limit
+	flushInterval := time.Duration(crump(req.GetFlushIntervalMillisec(), limit.BatchFlushIntervalMillisec)) * time.Millisecond
+	maxBatchSize := int(crump(req.GetMaxBatchSize(), limit.BatchMaxSize))
+	defer listener.listeners.remove(listener)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := newResultBatch()
+	flush := func() error {
+		if batch.isEmpty() {
+			return nil
+		}
+		err := send(batch.batch)
+		batch = newResultBatch()
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case message, ok := <-listener.ch:
+			if !ok {
+				if err := flush(); err != nil {
+					return err
+				}
+				return listener.err()
+			}
+
+			result := message.(*pb.IcmpResult)
+			batch.add(result)
+			if batch.size() >= maxBatchSize || result.GetType() == pb.IcmpResult_IcmpResultTypeSummary {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
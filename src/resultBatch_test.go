@@ -0,0 +1,136 @@
+package main
+
+import (
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+func TestResultBatchAdd(t *testing.T) {
+	result := func(ip string, sequence int64, sendTimeNanosec int64, receiveTimeNanosec int64) *pb.IcmpResult {
+		return &pb.IcmpResult{
+			Type:                   pb.IcmpResult_IcmpResultTypeReceive,
+			TargetID128:            net.ParseIP(ip).To16(),
+			Sequence:               sequence,
+			SendTimeUnixNanosec:    sendTimeNanosec,
+			ReceiveTimeUnixNanosec: receiveTimeNanosec,
+			StreamSequence:         uint64(sequence),
+		}
+	}
+	target := func(ip string) *pb.IcmpResultBatch_Target {
+		return &pb.IcmpResultBatch_Target{
+			TargetID128: net.ParseIP(ip).To16(),
+		}
+	}
+	summary := &pb.PingerSummary{Reason: pb.TerminationReason_TerminationReasonStopped}
+
+	tests := []struct {
+		name    string
+		results []*pb.IcmpResult
+		want    *pb.IcmpResultBatch
+	}{
+		{
+			name:    "empty",
+			results: []*pb.IcmpResult{},
+			want:    &pb.IcmpResultBatch{},
+		},
+		{
+			name: "send time from the previous result and receive time from its own send time",
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", 1, 1000, 1300),
+				result("10.0.0.2", 2, 1500, 1600),
+				result("10.0.0.1", 3, 1400, 0),
+			},
+			want: &pb.IcmpResultBatch{
+				BaseUnixNanosec: 1000,
+				Targets:         []*pb.IcmpResultBatch_Target{target("10.0.0.1"), target("10.0.0.2")},
+				Results: []*pb.IcmpResultBatch_Result{
+					{Type: pb.IcmpResult_IcmpResultTypeReceive, TargetIndex: 0, Sequence: 1, SendTimeDeltaNanosec: 0, ReceiveTimeDeltaNanosec: 300, StreamSequence: 1},
+					{Type: pb.IcmpResult_IcmpResultTypeReceive, TargetIndex: 1, Sequence: 2, SendTimeDeltaNanosec: 500, ReceiveTimeDeltaNanosec: 100, StreamSequence: 2},
+					{Type: pb.IcmpResult_IcmpResultTypeReceive, TargetIndex: 0, Sequence: 3, SendTimeDeltaNanosec: -100, ReceiveTimeDeltaNanosec: -1400, StreamSequence: 3},
+				},
+			},
+		},
+		{
+			name: "gap markers are summed",
+			results: []*pb.IcmpResult{
+				newResultGapMarker(2).(*pb.IcmpResult),
+				result("10.0.0.1", 1, 1000, 1300),
+				newResultGapMarker(3).(*pb.IcmpResult),
+			},
+			want: &pb.IcmpResultBatch{
+				BaseUnixNanosec: 1000,
+				DroppedCount:    5,
+				Targets:         []*pb.IcmpResultBatch_Target{target("10.0.0.1")},
+				Results: []*pb.IcmpResultBatch_Result{
+					{Type: pb.IcmpResult_IcmpResultTypeReceive, TargetIndex: 0, Sequence: 1, ReceiveTimeDeltaNanosec: 300, StreamSequence: 1},
+				},
+			},
+		},
+		{
+			name: "summary",
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", 1, 1000, 1300),
+				{Type: pb.IcmpResult_IcmpResultTypeSummary, Summary: summary},
+			},
+			want: &pb.IcmpResultBatch{
+				BaseUnixNanosec: 1000,
+				Targets:         []*pb.IcmpResultBatch_Target{target("10.0.0.1")},
+				Results: []*pb.IcmpResultBatch_Result{
+					{Type: pb.IcmpResult_IcmpResultTypeReceive, TargetIndex: 0, Sequence: 1, ReceiveTimeDeltaNanosec: 300, StreamSequence: 1},
+				},
+				Summary: summary,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch := newResultBatch()
+			for _, result := range tt.results {
+				batch.add(result)
+			}
+
+			if !proto.Equal(batch.batch, tt.want) {
+				t.Errorf("batch = %v, want %v", batch.batch, tt.want)
+			}
+			if batch.size() != len(tt.want.GetResults()) {
+				t.Errorf("size() = %d, want %d", batch.size(), len(tt.want.GetResults()))
+			}
+		})
+	}
+}
+
+func TestResultBatchDecode(t *testing.T) {
+	results := []*pb.IcmpResult{
+		{Type: pb.IcmpResult_IcmpResultTypeReceive, TargetID128: net.ParseIP("10.0.0.1").To16(), SendTimeUnixNanosec: 5000, ReceiveTimeUnixNanosec: 5200},
+		{Type: pb.IcmpResult_IcmpResultTypeTimeout, TargetID128: net.ParseIP("fd00::1").To16(), SendTimeUnixNanosec: 6000},
+		{Type: pb.IcmpResult_IcmpResultTypeReceive, TargetID128: net.ParseIP("10.0.0.1").To16(), SendTimeUnixNanosec: 5500, ReceiveTimeUnixNanosec: 7000},
+	}
+
+	batch := newResultBatch()
+	for _, result := range results {
+		batch.add(result)
+	}
+
+	//受け取る側と同じ手順で元に戻す
+	sendTimeNanosec := batch.batch.GetBaseUnixNanosec()
+	for i, encoded := range batch.batch.GetResults() {
+		sendTimeNanosec += encoded.GetSendTimeDeltaNanosec()
+		receiveTimeNanosec := sendTimeNanosec + encoded.GetReceiveTimeDeltaNanosec()
+		targetID128 := batch.batch.GetTargets()[encoded.GetTargetIndex()].GetTargetID128()
+
+		if sendTimeNanosec != results[i].GetSendTimeUnixNanosec() {
+			t.Errorf("result %d send time = %d, want %d", i, sendTimeNanosec, results[i].GetSendTimeUnixNanosec())
+		}
+		if receiveTimeNanosec != results[i].GetReceiveTimeUnixNanosec() {
+			t.Errorf("result %d receive time = %d, want %d", i, receiveTimeNanosec, results[i].GetReceiveTimeUnixNanosec())
+		}
+		if !net.IP(targetID128).Equal(net.IP(results[i].GetTargetID128())) {
+			t.Errorf("result %d target = %v, want %v", i, net.IP(targetID128), net.IP(results[i].GetTargetID128()))
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/umenosuke/ping-grpc-server/pinger46"
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+//リスナーごとの絞り込み、リスナーのロック内でだけ使う
+type tResultFilter struct {
+	//nilなら全ての対象
+	targets        map[tResultFilterTarget]struct{}
+	nonReceiveOnly bool
+	minRttNanosec  int64
+	everyNth       uint64
+	matchedCount   map[tResultFilterTarget]uint64
+}
+
+type tResultFilterTarget struct {
+	targetID128 string
+	probeType   pb.ProbeType
+	port        uint32
+}
+
+func newResultFilter(req *pb.StreamRequest) (*tResultFilter, error) {
+	filter := &tResultFilter{
+		nonReceiveOnly: req.GetFilterNonReceiveOnly(),
+		minRttNanosec:  req.GetFilterMinRttNanosec(),
+		everyNth:       req.GetFilterEveryNth(),
+		matchedCount:   make(map[tResultFilterTarget]uint64),
+	}
+
+	if filterTargets := req.GetFilterTargets(); len(filterTargets) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0)
+		filter.targets = make(map[tResultFilterTarget]struct{})
+		for i, target := range filterTargets {
+			targetID, err := pinger46.ResolveTarget(target.GetTargetIP())
+			if err != nil {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{
+					Field:       "FilterTargets[" + strconv.Itoa(i) + "].TargetIP",
+					Description: err.Error(),
+				})
+				continue
+			}
+			filter.targets[tResultFilterTarget{
+				targetID128: string(pinger46.BinIPAddress2Bytes(targetID.BinIP)),
+				probeType:   probeType2pb(targetID.ProbeType),
+				port:        uint32(targetID.Port),
+			}] = struct{}{}
+		}
+		if len(violations) > 0 {
+			return nil, errInvalidArgument("invalid filter targets", violations)
+		}
+	}
+	if filter.minRttNanosec < 0 {
+		return nil, errInvalidArgument("invalid filter", []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       "FilterMinRttNanosec",
+				Description: "must not be negative",
+			},
+		})
+	}
+
+	return filter, nil
+}
+
+//NonReceiveOnlyとMinRttNanosecを両方指定した時は、失敗か遅い応答のどちらかを通す
+func (thisFilter *tResultFilter) match(result *pb.IcmpResult) bool {
+	if result.GetType() == pb.IcmpResult_IcmpResultTypeSummary {
+		return true
+	}
+
+	target := tResultFilterTarget{
+		targetID128: string(result.GetTargetID128()),
+		probeType:   result.GetProbeType(),
+		port:        result.GetPort(),
+	}
+	if thisFilter.targets != nil {
+		if _, ok := thisFilter.targets[target]; !ok {
+			return false
+		}
+	}
+
+	if thisFilter.nonReceiveOnly || thisFilter.minRttNanosec > 0 {
+		if result.GetType() == pb.IcmpResult_IcmpResultTypeReceive {
+			if thisFilter.minRttNanosec <= 0 || result.GetReceiveTimeUnixNanosec()-result.GetSendTimeUnixNanosec() < thisFilter.minRttNanosec {
+				return false
+			}
+		} else if !thisFilter.nonReceiveOnly {
+			return false
+		}
+	}
+
+	//他の条件を通ったものを対象ごとに数えて間引く
+	if thisFilter.everyNth > 1 {
+		thisFilter.matchedCount[target]++
+		if thisFilter.matchedCount[target]%thisFilter.everyNth != 1 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+func TestResultFilterMatch(t *testing.T) {
+	result := func(ip string, resultType pb.IcmpResult_ResultType, rttNanosec int64) *pb.IcmpResult {
+		return &pb.IcmpResult{
+			Type:                   resultType,
+			TargetID128:            net.ParseIP(ip).To16(),
+			SendTimeUnixNanosec:    1000,
+			ReceiveTimeUnixNanosec: 1000 + rttNanosec,
+		}
+	}
+	receive := pb.IcmpResult_IcmpResultTypeReceive
+	timeout := pb.IcmpResult_IcmpResultTypeTimeout
+	summary := pb.IcmpResult_IcmpResultTypeSummary
+
+	tests := []struct {
+		name    string
+		req     *pb.StreamRequest
+		results []*pb.IcmpResult
+		want    []bool
+	}{
+		{
+			name: "no filter",
+			req:  &pb.StreamRequest{},
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", receive, 10),
+				result("10.0.0.2", timeout, 0),
+			},
+			want: []bool{true, true},
+		},
+		{
+			name: "targets",
+			req: &pb.StreamRequest{
+				FilterTargets: []*pb.StartRequest_IcmpTarget{{TargetIP: "10.0.0.1"}, {TargetIP: "fd00::1"}},
+			},
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", receive, 10),
+				result("10.0.0.2", receive, 10),
+				result("fd00::1", receive, 10),
+			},
+			want: []bool{true, false, true},
+		},
+		{
+			name: "non receive only",
+			req:  &pb.StreamRequest{FilterNonReceiveOnly: true},
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", receive, 10),
+				result("10.0.0.1", timeout, 0),
+			},
+			want: []bool{false, true},
+		},
+		{
+			name: "min rtt",
+			req:  &pb.StreamRequest{FilterMinRttNanosec: 100},
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", receive, 99),
+				result("10.0.0.1", receive, 100),
+				result("10.0.0.1", timeout, 0),
+			},
+			want: []bool{false, true, false},
+		},
+		{
+			name: "non receive or slow",
+			req:  &pb.StreamRequest{FilterNonReceiveOnly: true, FilterMinRttNanosec: 100},
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", receive, 99),
+				result("10.0.0.1", receive, 100),
+				result("10.0.0.1", timeout, 0),
+			},
+			want: []bool{false, true, true},
+		},
+		{
+			name: "every nth per target",
+			req:  &pb.StreamRequest{FilterEveryNth: 2},
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", receive, 10),
+				result("10.0.0.2", receive, 10),
+				result("10.0.0.1", receive, 10),
+				result("10.0.0.2", receive, 10),
+				result("10.0.0.1", receive, 10),
+			},
+			want: []bool{true, true, false, false, true},
+		},
+		{
+			name: "every nth counts only matched",
+			req:  &pb.StreamRequest{FilterEveryNth: 2, FilterNonReceiveOnly: true},
+			results: []*pb.IcmpResult{
+				result("10.0.0.1", timeout, 0),
+				result("10.0.0.1", receive, 10),
+				result("10.0.0.1", timeout, 0),
+				result("10.0.0.1", timeout, 0),
+			},
+			want: []bool{true, false, false, true},
+		},
+		{
+			name: "summary always passes",
+			req: &pb.StreamRequest{
+				FilterTargets:        []*pb.StartRequest_IcmpTarget{{TargetIP: "10.0.0.1"}},
+				FilterNonReceiveOnly: true,
+			},
+			results: []*pb.IcmpResult{
+				{Type: summary},
+			},
+			want: []bool{true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newResultFilter(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make([]bool, 0, len(tt.results))
+			for _, result := range tt.results {
+				got = append(got, filter.match(result))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewResultFilterInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.StreamRequest
+	}{
+		{"unparsable target", &pb.StreamRequest{FilterTargets: []*pb.StartRequest_IcmpTarget{{TargetIP: "tcp://10.0.0.1"}}}},
+		{"negative min rtt", &pb.StreamRequest{FilterMinRttNanosec: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newResultFilter(tt.req); err == nil {
+				t.Errorf("newResultFilter() error = nil, want error")
+			}
+		})
+	}
+}
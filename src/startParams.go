@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/umenosuke/ping-grpc-server/pinger46"
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+type tStartParams struct {
+	config                pinger46.Config
+	stopPingerSec         uint64
+	statisticsIntervalSec uint64
+	replayBufferSize      uint64
+	adjustments           []*pb.StartResponse_Adjustment
+}
+
+//リクエストの値を制限内に収めた実際に使う値と、変更した項目
+func (thisServer *pingerServer) startParams(request tStartReq) tStartParams {
+	res := tStartParams{
+		config:      pinger46.DefaultConfig(),
+		adjustments: make([]*pb.StartResponse_Adjustment, 0),
+	}
+	limit := thisServer.config.Limit
+	adjust := func(field string, requested uint64, effective uint64) uint64 {
+		//0は未指定扱い
+		if requested != 0 && requested != effective {
+			res.adjustments = append(res.adjustments, &pb.StartResponse_Adjustment{
+				Field:       field,
+				Description: "requested " + strconv.FormatUint(requested, 10) + ", using " + strconv.FormatUint(effective, 10),
+			})
+		}
+		return effective
+	}
+
+	config := &res.config
+	config.DebugEnable = argDebugFlag
+	config.DebugPrintIntervalSec = debugPrintIntervalSec
+	config.SourceIPAddress = thisServer.config.ICMPSourceIPAddress
+	config.SourceIPv6Address = thisServer.config.ICMPv6SourceIPAddress
+	config.IntervalMillisec = int64(adjust("IntervalMillisec", request.intervalMillisec, crump(request.intervalMillisec, limit.IntervalMillisec)))
+	config.StatisticsCountsNum = int64(adjust("StatisticsCountsNum", request.statisticsCountsNum, crump(request.statisticsCountsNum, limit.StatisticsCountsNum)))
+	if request.mode == pb.PingerMode_PingerModeTrace {
+		config.Mode = pinger46.ModeTrace
+		if request.maxHops > 0 {
+			config.MaxHops = int64(adjust("MaxHops", request.maxHops, crump(request.maxHops, limit.MaxHops)))
+		}
+	}
+	{
+		//seqが一周しないようにpinger側でも短くされるので、先に合わせておく
+		timeoutMillisec := crump(request.timeoutMillisec, limit.TimeoutMillisec)
+		if maxTimeoutMillisec := uint64(pinger46.MaxTimeoutMillisec(config.IntervalMillisec, config.Mode, config.MaxHops)); timeoutMillisec > maxTimeoutMillisec {
+			timeoutMillisec = maxTimeoutMillisec
+		}
+		config.TimeoutMillisec = int64(adjust("TimeoutMillisec", request.timeoutMillisec, timeoutMillisec))
+	}
+	if request.ttl > 0 {
+		config.TTL = int64(adjust("TTL", request.ttl, crump(request.ttl, limit.TTL)))
+	}
+	config.TOS = int64(adjust("TOS", request.tos, crump(request.tos, limit.TOS)))
+	config.PayloadSize = int64(adjust("PayloadSize", request.payloadSize, crump(request.payloadSize, limit.PayloadSize)))
+	config.PayloadPattern = request.payloadPattern
+	config.DontFragment = request.dontFragment
+	config.StatisticsWindowsSec = statisticsWindowsSec(request.statisticsWindowsSec, limit)
+	if !isSameWindowsSec(request.statisticsWindowsSec, config.StatisticsWindowsSec) {
+		res.adjustments = append(res.adjustments, &pb.StartResponse_Adjustment{
+			Field:       "StatisticsWindowsSec",
+			Description: fmt.Sprintf("requested %v, using %v", request.statisticsWindowsSec, config.StatisticsWindowsSec),
+		})
+	}
+	if request.downLossCount > 0 {
+		config.DownLossCount = int64(adjust("DownLossCount", request.downLossCount, crump(request.downLossCount, limit.DownLossCount)))
+	}
+	if request.upSuccessCount > 0 {
+		config.UpSuccessCount = int64(adjust("UpSuccessCount", request.upSuccessCount, crump(request.upSuccessCount, limit.UpSuccessCount)))
+	}
+	if request.degradedLossPercent > 0 && request.degradedLossPercent <= 100 {
+		config.DegradedLossPercent = request.degradedLossPercent
+	} else if request.degradedLossPercent != 0 {
+		res.adjustments = append(res.adjustments, &pb.StartResponse_Adjustment{
+			Field:       "DegradedLossPercent",
+			Description: "requested " + strconv.FormatFloat(request.degradedLossPercent, 'f', -1, 64) + ", must be in (0, 100], ignored",
+		})
+	}
+	config.HoldDownMillisec = int64(adjust("HoldDownMillisec", request.holdDownMillisec, crump(request.holdDownMillisec, limit.HoldDownMillisec)))
+	config.Count = int64(adjust("Count", request.count, crump(request.count, limit.Count)))
+	config.MaxTargets = int64(limit.TargetsNum.Max)
+
+	res.stopPingerSec = adjust("StopPingerSec", request.stopPingerSec, crump(request.stopPingerSec, limit.StopPingerSec))
+	res.statisticsIntervalSec = adjust("StatisticsIntervalSec", request.statisticsIntervalSec, crump(request.statisticsIntervalSec, limit.StatisticsIntervalSec))
+	res.replayBufferSize = adjust("ReplayBufferSize", request.replayBufferSize, crump(request.replayBufferSize, limit.ReplayBufferSize))
+
+	return res
+}
+
+func isSameWindowsSec(requested []uint64, effective []int64) bool {
+	if len(requested) != len(effective) {
+		return false
+	}
+	for i := range requested {
+		if requested[i] != uint64(effective[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/umenosuke/labelinglog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newStatusError(code codes.Code, msg string, details ...proto.Message) error {
+	st := status.New(code, msg)
+	if stWithDetails, err := st.WithDetails(details...); err == nil {
+		st = stWithDetails
+	} else {
+		logger.Log(labelinglog.FlgWarn, "status details fail : "+err.Error())
+	}
+
+	return st.Err()
+}
+
+func errPingerNotFound(handle string) error {
+	return newStatusError(codes.NotFound, "pinger not found : "+handle, &errdetails.ResourceInfo{
+		ResourceType: "pinger",
+		ResourceName: handle,
+		Description:  "not found or already stopped",
+	})
+}
+
+func errIcmpIDExhausted() error {
+	return newStatusError(codes.ResourceExhausted, "no ICMP identifier available", &errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{
+			{
+				Subject:     "ICMP identifier",
+				Description: "all ICMP identifiers are in use",
+			},
+		},
+	})
+}
+
+func errInvalidArgument(msg string, violations []*errdetails.BadRequest_FieldViolation) error {
+	return newStatusError(codes.InvalidArgument, msg, &errdetails.BadRequest{
+		FieldViolations: violations,
+	})
+}
+
+func errFailedPrecondition(msg string) error {
+	return status.Error(codes.FailedPrecondition, msg)
+}
+
+func errInternal(msg string) error {
+	return status.Error(codes.Internal, msg)
+}
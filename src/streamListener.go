@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+type tStreamListeners struct {
+	sync.Mutex
+	//nilなら終了済み
+	list []*tStreamListener
+	//全リスナーで落とした数の合計
+	droppedCount uint64
+	//pinger内で落ちた数、増えた分を各リスナーの抜けとして通知する
+	pingerDroppedCount int64
+}
+
+type tStreamListener struct {
+	//クライアントへ送る側が読む
+	ch chan proto.Message
+	//共有のロックを持って書き込む、Blockの方針ではクライアントを待つのをforwardに任せるのでchとは別
+	chIn chan proto.Message
+	//forwardを止める、外された時に閉じる
+	chStop   chan struct{}
+	stopOnce sync.Once
+	//forwardが待ちきれずに落とした数、sendで抜けに移す
+	forwardDroppedCount uint64
+	bufferSize          uint
+	policy              pb.StreamDropPolicy
+	blockTimeout        time.Duration
+	//nilなら全て通す
+	match func(proto.Message) bool
+	//抜けを知らせるメッセージ
+	newGapMarker func(droppedCount uint64) proto.Message
+	//まだ通知していない抜けの数
+	droppedCount   uint64
+	isDisconnected bool
+	//登録先、送信を終える時に外すため
+	listeners *tStreamListeners
+}
+
+func newStreamListeners() tStreamListeners {
+	return tStreamListeners{
+		list: make([]*tStreamListener, 0),
+	}
+}
+
+func (thisServer *pingerServer) newStreamListener(req *pb.StreamRequest, newGapMarker func(droppedCount uint64) proto.Message) *tStreamListener {
+	return &tStreamListener{
+		bufferSize:   thisServer.config.GrpcStreamBuffer,
+		policy:       req.GetDropPolicy(),
+		blockTimeout: time.Duration(crump(req.GetBlockTimeoutMillisec(), thisServer.config.Limit.StreamBlockTimeoutMillisec)) * time.Millisecond,
+		newGapMarker: newGapMarker,
+	}
+}
+
+//addWithoutLock 再送分を先に詰めてから登録するので、再送と以降の間に抜けはない、終了済みならチャネルを閉じる
+//再送できない分があれば、その数を抜けとして最初に知らせる
+func (thisListeners *tStreamListeners) addWithoutLock(listener *tStreamListener, replay []interface{}, missedCount uint64) {
+	messages := make([]proto.Message, 0, len(replay)+1)
+	if missedCount > 0 {
+		messages = append(messages, listener.newGapMarker(missedCount))
+	}
+	for _, value := range replay {
+		message := value.(proto.Message)
+		if listener.match == nil || listener.match(message) {
+			messages = append(messages, message)
+		}
+	}
+	//終了時の最後のメッセージのために一つ空けておく
+	listener.chIn = make(chan proto.Message, listener.bufferSize+uint(len(messages))+1)
+	listener.ch = listener.chIn
+	listener.listeners = thisListeners
+	if listener.policy == pb.StreamDropPolicy_StreamDropPolicyBlock {
+		listener.ch = make(chan proto.Message, listener.bufferSize)
+		listener.chStop = make(chan struct{})
+		go listener.forward()
+	}
+	for _, message := range messages {
+		listener.chIn <- message
+	}
+
+	if thisListeners.list != nil {
+		thisListeners.list = append(thisListeners.list, listener)
+	} else {
+		listener.close()
+	}
+}
+
+//forward Blockの方針で、共有のロックの外で遅いクライアントを待つ、chInが閉じたら残りを送ってからchを閉じる
+func (thisListener *tStreamListener) forward() {
+	defer close(thisListener.ch)
+
+	for {
+		var message proto.Message
+		select {
+		case <-thisListener.chStop:
+			return
+		case m, ok := <-thisListener.chIn:
+			if !ok {
+				return
+			}
+			message = m
+		}
+
+		select {
+		case thisListener.ch <- message:
+			continue
+		default:
+		}
+
+		timer := time.NewTimer(thisListener.blockTimeout)
+		select {
+		case <-thisListener.chStop:
+			timer.Stop()
+			return
+		case thisListener.ch <- message:
+			timer.Stop()
+		case <-timer.C:
+			if n := gapMarkerDroppedCount(message); n > 0 {
+				atomic.AddUint64(&thisListener.forwardDroppedCount, n)
+			} else {
+				atomic.AddUint64(&thisListener.forwardDroppedCount, 1)
+				atomic.AddUint64(&thisListener.listeners.droppedCount, 1)
+			}
+		}
+	}
+}
+
+//close これ以上送らない、forwardは残りを送ってからchを閉じる
+func (thisListener *tStreamListener) close() {
+	close(thisListener.chIn)
+}
+
+//stopForward forwardに残りを捨てさせる
+func (thisListener *tStreamListener) stopForward() {
+	if thisListener.chStop == nil {
+		return
+	}
+	thisListener.stopOnce.Do(func() {
+		close(thisListener.chStop)
+	})
+}
+
+func (thisListeners *tStreamListeners) closeAll() {
+	thisListeners.Lock()
+	defer thisListeners.Unlock()
+
+	for _, listener := range thisListeners.list {
+		listener.close()
+	}
+	thisListeners.list = nil
+}
+
+//remove 一覧に残っていれば外して止める、終了済みならchInは既に閉じているのでforwardだけ止める
+func (thisListeners *tStreamListeners) remove(listener *tStreamListener) {
+	thisListeners.Lock()
+	defer thisListeners.Unlock()
+
+	for i, l := range thisListeners.list {
+		if l == listener {
+			thisListeners.list = append(thisListeners.list[:i], thisListeners.list[i+1:]...)
+			listener.close()
+			break
+		}
+	}
+	listener.stopForward()
+}
+
+//sendAll チャネルが閉じるかクライアントが切断するまで送る、終えたら登録先から外す
+func (thisListener *tStreamListener) sendAll(ctx context.Context, send func(proto.Message) error) error {
+	defer thisListener.listeners.remove(thisListener)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case message, ok := <-thisListener.ch:
+			if !ok {
+				return thisListener.err()
+			}
+			if err := send(message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+//pinger内で落ちた数の累計を受け取り、前回から増えた分を各リスナーの抜けに加える
+func (thisListeners *tStreamListeners) setPingerDroppedCountWithoutLock(pingerDroppedCount int64) {
+	if pingerDroppedCount <= thisListeners.pingerDroppedCount {
+		return
+	}
+
+	delta := uint64(pingerDroppedCount - thisListeners.pingerDroppedCount)
+	thisListeners.pingerDroppedCount = pingerDroppedCount
+	for _, listener := range thisListeners.list {
+		listener.droppedCount += delta
+	}
+}
+
+//sendWithoutLock 各リスナーの方針に従って送る、遅いリスナーを切断した時は一覧から外す
+func (thisListeners *tStreamListeners) sendWithoutLock(message proto.Message) {
+	isDisconnected := false
+	for _, listener := range thisListeners.list {
+		if listener.match != nil && !listener.match(message) {
+			continue
+		}
+
+		droppedCount := listener.send(message)
+		atomic.AddUint64(&thisListeners.droppedCount, droppedCount)
+		if listener.isDisconnected {
+			isDisconnected = true
+		}
+	}
+
+	if isDisconnected {
+		list := make([]*tStreamListener, 0, len(thisListeners.list))
+		for _, listener := range thisListeners.list {
+			if listener.isDisconnected {
+				listener.close()
+			} else {
+				list = append(list, listener)
+			}
+		}
+		thisListeners.list = list
+	}
+}
+
+//sendFinalWithoutLock 終了時の最後のメッセージは方針に関係なく、空けておいた場所に入れる
+func (thisListeners *tStreamListeners) sendFinalWithoutLock(message proto.Message) {
+	for _, listener := range thisListeners.list {
+		select {
+		case listener.chIn <- message:
+		default:
+		}
+	}
+}
+
+func (thisListeners *tStreamListeners) getDroppedCount() uint64 {
+	return atomic.LoadUint64(&thisListeners.droppedCount)
+}
+
+//send 溜まっている抜けを先に知らせてから送る、新しく落とした数を返す
+func (thisListener *tStreamListener) send(message proto.Message) uint64 {
+	droppedCount := uint64(0)
+
+	thisListener.droppedCount += atomic.SwapUint64(&thisListener.forwardDroppedCount, 0)
+	if thisListener.droppedCount > 0 {
+		gapMarker := thisListener.newGapMarker(thisListener.droppedCount)
+		thisListener.droppedCount = 0
+		if !thisListener.push(gapMarker, &droppedCount) {
+			//抜けの通知ごと落ちたので、次にまとめて知らせる
+			thisListener.droppedCount += gapMarkerDroppedCount(gapMarker) + 1
+			return droppedCount + 1
+		}
+	}
+
+	if !thisListener.push(message, &droppedCount) {
+		thisListener.droppedCount++
+		droppedCount++
+	}
+
+	return droppedCount
+}
+
+//push 入らなかった時はfalse、古いものを捨てた時はdroppedCountに足す、共有のロックを持ったまま待つことはない
+func (thisListener *tStreamListener) push(message proto.Message, droppedCount *uint64) bool {
+	if thisListener.isDisconnected {
+		return false
+	}
+
+	if thisListener.tryPush(message) {
+		return true
+	}
+
+	switch thisListener.policy {
+	case pb.StreamDropPolicy_StreamDropPolicyDropOldest:
+		for !thisListener.tryPush(message) {
+			select {
+			case oldMessage := <-thisListener.chIn:
+				if n := gapMarkerDroppedCount(oldMessage); n > 0 {
+					thisListener.droppedCount += n
+				} else {
+					thisListener.droppedCount++
+					*droppedCount++
+				}
+			default:
+			}
+		}
+		return true
+	case pb.StreamDropPolicy_StreamDropPolicyBlock:
+		//クライアントを待つのはforwardで、chInまで溢れたら落とす
+		return false
+	case pb.StreamDropPolicy_StreamDropPolicyDisconnect:
+		thisListener.isDisconnected = true
+		return false
+	default:
+		return false
+	}
+}
+
+//tryPush 最後のメッセージの分を空けて入れる、書き込むのは共有のロックを持った側だけなので入ると決めた後に詰まることはない
+func (thisListener *tStreamListener) tryPush(message proto.Message) bool {
+	if len(thisListener.chIn) >= cap(thisListener.chIn)-1 {
+		return false
+	}
+	thisListener.chIn <- message
+
+	return true
+}
+
+//err チャネルが閉じた後に呼ぶ
+func (thisListener *tStreamListener) err() error {
+	if thisListener.isDisconnected {
+		return status.Error(codes.ResourceExhausted, "slow consumer disconnected")
+	}
+
+	return nil
+}
+
+//抜けの通知ならそれが表す数、それ以外は0
+func gapMarkerDroppedCount(message proto.Message) uint64 {
+	if gapMarker, ok := message.(interface{ GetDroppedCount() uint64 }); ok {
+		return gapMarker.GetDroppedCount()
+	}
+
+	return 0
+}
+
+func newResultGapMarker(droppedCount uint64) proto.Message {
+	return &pb.IcmpResult{
+		Type:         pb.IcmpResult_IcmpResultTypeGap,
+		DroppedCount: droppedCount,
+	}
+}
+
+func newStatisticsGapMarker(droppedCount uint64) proto.Message {
+	return &pb.Statistics{
+		DroppedCount: droppedCount,
+	}
+}
+
+func newHopTableGapMarker(droppedCount uint64) proto.Message {
+	return &pb.HopTable{
+		Type:         pb.HopTable_HopTableTypeGap,
+		DroppedCount: droppedCount,
+	}
+}
+
+func newTargetStateGapMarker(droppedCount uint64) proto.Message {
+	return &pb.TargetStateChange{
+		DroppedCount: droppedCount,
+	}
+}
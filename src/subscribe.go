@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/umenosuke/labelinglog"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
+)
+
+//一つのpingerへの購読、終了済みのpingerならリスナーは既に閉じている
+type tSubscription struct {
+	p          *tPingerWrap
+	result     *tStreamListener
+	statistics *tStreamListener
+}
+
+//subscribe 一本のストリームで複数のpingerの結果と統計、購読しているpingerの出来事を送る
+//送信は呼び出し元のgoroutineだけで行い、各リスナーの中身はpingerのIDを付けて一つのチャネルにまとめる
+func (thisServer *pingerServer) subscribe(ctx context.Context, recv func() (*pb.SubscribeRequest, error), send func(*pb.SubscribeMessage) error) error {
+	childCtx, childCtxCancel := context.WithCancel(ctx)
+	wgChild := sync.WaitGroup{}
+
+	chRequest := make(chan *pb.SubscribeRequest)
+	chRecvErr := make(chan error, 1)
+	go (func() {
+		for {
+			req, err := recv()
+			if err != nil {
+				chRecvErr <- err
+				return
+			}
+			select {
+			case <-childCtx.Done():
+				return
+			case chRequest <- req:
+			}
+		}
+	})()
+
+	eventSubscriber := thisServer.events.subscribe(thisServer.config.GrpcStreamBuffer)
+	chMessage := make(chan *pb.SubscribeMessage, thisServer.config.GrpcStreamBuffer)
+	subscriptions := make(map[string]*tSubscription)
+
+	unsubscribe := func(subscription *tSubscription) {
+		if subscription.result != nil {
+			subscription.p.chResultListener.remove(subscription.result)
+		}
+		if subscription.statistics != nil {
+			subscription.p.chStatisticsListener.remove(subscription.statistics)
+		}
+	}
+	defer (func() {
+		childCtxCancel()
+		thisServer.events.unsubscribe(eventSubscriber)
+		for _, subscription := range subscriptions {
+			unsubscribe(subscription)
+		}
+		wgChild.Wait()
+	})()
+
+	forward := func(handle string, listener *tStreamListener, newMessage func(proto.Message) *pb.SubscribeMessage) {
+		wgChild.Add(1)
+		go (func() {
+			defer wgChild.Done()
+
+			for message := range listener.ch {
+				select {
+				case <-childCtx.Done():
+					return
+				case chMessage <- newMessage(message):
+				}
+			}
+			if err := listener.err(); err != nil {
+				select {
+				case <-childCtx.Done():
+				case chMessage <- &pb.SubscribeMessage{
+					Type:   pb.SubscribeMessage_SubscribeMessageTypeError,
+					Handle: handle,
+					Error:  err.Error(),
+				}:
+				}
+			}
+		})()
+	}
+
+	add := func(req *pb.SubscribeRequest) *pb.SubscribeMessage {
+		stream := req.GetStream()
+		handle := stream.GetHandle()
+		isResults := req.GetResults()
+		isStatistics := req.GetStatistics()
+		//どちらも指定がなければ両方
+		if !isResults && !isStatistics {
+			isResults = true
+			isStatistics = true
+		}
+
+		//既に購読していれば条件を入れ替える
+		if subscription, ok := subscriptions[handle]; ok {
+			unsubscribe(subscription)
+			delete(subscriptions, handle)
+		}
+
+		p, err := thisServer.getPingerWrap(handle)
+		if err != nil {
+			return newSubscribeErrorMessage(handle, err)
+		}
+		subscription := &tSubscription{p: p}
+
+		if isResults {
+			listener, err := thisServer.getsIcmpResult(stream)
+			if err != nil {
+				return newSubscribeErrorMessage(handle, err)
+			}
+			subscription.result = listener
+		}
+		if isStatistics {
+			listener, err := thisServer.getsStatistics(stream)
+			if err != nil {
+				unsubscribe(subscription)
+				return newSubscribeErrorMessage(handle, err)
+			}
+			subscription.statistics = listener
+		}
+
+		if subscription.result != nil {
+			forward(handle, subscription.result, func(message proto.Message) *pb.SubscribeMessage {
+				return &pb.SubscribeMessage{
+					Type:   pb.SubscribeMessage_SubscribeMessageTypeResult,
+					Handle: handle,
+					Result: message.(*pb.IcmpResult),
+				}
+			})
+		}
+		if subscription.statistics != nil {
+			forward(handle, subscription.statistics, func(message proto.Message) *pb.SubscribeMessage {
+				return &pb.SubscribeMessage{
+					Type:       pb.SubscribeMessage_SubscribeMessageTypeStatistics,
+					Handle:     handle,
+					Statistics: message.(*pb.Statistics),
+				}
+			})
+		}
+		subscriptions[handle] = subscription
+
+		logger.Log(labelinglog.FlgDebug, "subscribe add : "+handle)
+		return &pb.SubscribeMessage{
+			Type:   pb.SubscribeMessage_SubscribeMessageTypeSubscribed,
+			Handle: handle,
+		}
+	}
+
+	remove := func(req *pb.SubscribeRequest) *pb.SubscribeMessage {
+		handle := req.GetStream().GetHandle()
+		subscription, ok := subscriptions[handle]
+		if !ok {
+			return newSubscribeErrorMessage(handle, errFailedPrecondition("not subscribed : "+handle))
+		}
+		unsubscribe(subscription)
+		delete(subscriptions, handle)
+
+		logger.Log(labelinglog.FlgDebug, "subscribe remove : "+handle)
+		return &pb.SubscribeMessage{
+			Type:   pb.SubscribeMessage_SubscribeMessageTypeUnsubscribed,
+			Handle: handle,
+		}
+	}
+
+	for {
+		var message *pb.SubscribeMessage
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case err := <-chRecvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case req := <-chRequest:
+			switch req.GetAction() {
+			case pb.SubscribeRequest_SubscribeActionAdd:
+				message = add(req)
+			case pb.SubscribeRequest_SubscribeActionRemove:
+				message = remove(req)
+			default:
+				message = &pb.SubscribeMessage{
+					Type:   pb.SubscribeMessage_SubscribeMessageTypeError,
+					Handle: req.GetStream().GetHandle(),
+					Error:  "unknown action : " + req.GetAction().String(),
+				}
+			}
+		case message = <-chMessage:
+		case event := <-eventSubscriber.ch:
+			message = newSubscribeEventMessage(subscriptions, event)
+			if message == nil {
+				continue
+			}
+		case <-eventSubscriber.chDropped:
+			//溜まっている出来事を先に送ってから抜けを知らせる
+			for len(eventSubscriber.ch) > 0 {
+				eventMessage := newSubscribeEventMessage(subscriptions, <-eventSubscriber.ch)
+				if eventMessage == nil {
+					continue
+				}
+				if err := send(eventMessage); err != nil {
+					return err
+				}
+			}
+			message = &pb.SubscribeMessage{
+				Type:  pb.SubscribeMessage_SubscribeMessageTypeEvent,
+				Event: newPingerEventGapMarker(thisServer.events.takeDroppedCount(eventSubscriber)),
+			}
+		}
+
+		if err := send(message); err != nil {
+			return err
+		}
+	}
+}
+
+//購読していないpingerの出来事ならnil
+func newSubscribeEventMessage(subscriptions map[string]*tSubscription, event *pb.PingerEvent) *pb.SubscribeMessage {
+	if _, ok := subscriptions[event.GetHandle()]; !ok {
+		return nil
+	}
+
+	//終了したpingerのリスナーは閉じているので購読の一覧からも外す
+	switch event.GetType() {
+	case pb.PingerEvent_PingerEventTypeStopped, pb.PingerEvent_PingerEventTypeExpired:
+		delete(subscriptions, event.GetHandle())
+	}
+
+	return &pb.SubscribeMessage{
+		Type:   pb.SubscribeMessage_SubscribeMessageTypeEvent,
+		Handle: event.GetHandle(),
+		Event:  event,
+	}
+}
+
+func newSubscribeErrorMessage(handle string, err error) *pb.SubscribeMessage {
+	return &pb.SubscribeMessage{
+		Type:   pb.SubscribeMessage_SubscribeMessageTypeError,
+		Handle: handle,
+		Error:  status.Convert(err).Message(),
+	}
+}
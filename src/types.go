@@ -1,16 +1,39 @@
 package main
 
 import (
+	"github.com/umenosuke/ping-grpc-server/pinger46"
 	pb "github.com/umenosuke/ping-grpc-server/proto/pingGrpc"
 )
 
+//一度だけ名前解決した対象、受け付けられなければerrに理由
+type tStartTarget struct {
+	target   *pb.StartRequest_IcmpTarget
+	targetID pinger46.TargetID
+	err      error
+}
+
 type tStartReq struct {
-	id                    uint16
+	handle                string
+	icmpID                uint16
 	description           string
-	targets               []*pb.StartRequest_IcmpTarget
+	targets               []tStartTarget
 	intervalMillisec      uint64
 	timeoutMillisec       uint64
 	stopPingerSec         uint64
 	statisticsCountsNum   uint64
 	statisticsIntervalSec uint64
+	mode                  pb.PingerMode
+	maxHops               uint64
+	ttl                   uint64
+	tos                   uint64
+	payloadSize           uint64
+	payloadPattern        []byte
+	dontFragment          bool
+	statisticsWindowsSec  []uint64
+	downLossCount         uint64
+	upSuccessCount        uint64
+	degradedLossPercent   float64
+	holdDownMillisec      uint64
+	count                 uint64
+	replayBufferSize      uint64
 }